@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show, change and check settings.yaml",
+	Long: `Reads and writes the settings in settings.yaml. Every key listed by
+"courtside config get" can also be overridden for a single run with its
+environment variable, such as COURTSIDE_REFRESH_LIVE_LIST=10s.`,
+}
+
+var configPathCmd = &cobra.Command{
+	Use:           "path",
+	Short:         "Print the path of settings.yaml",
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := data.SettingsPath()
+		if err != nil {
+			return err
+		}
+		fmt.Println(path)
+		return nil
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get [key]",
+	Short: "Print the value of a key, or of every key",
+	Long: `Prints the value in effect for a key: from the environment, settings.yaml
+or the default, in that order. Without a key, lists every key with its value,
+where it comes from and its environment variable; secrets such as the Reddit
+password are shown as set or unset, and printed only when asked for by name.`,
+	Args:          cobra.MaximumNArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := data.ReadSettings()
+		if err != nil {
+			return err
+		}
+		effective, envErr := settings.Effective()
+
+		if len(args) == 1 {
+			value, err := effective.Get(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Println(value)
+			return envErr
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "KEY\tVALUE\tFROM\tENVIRONMENT")
+		for _, key := range data.ConfigKeys() {
+			value, _ := effective.Get(key.Name)
+			switch {
+			case key.Secret && value != "":
+				value = "(set)"
+			case key.Secret:
+				value = "(unset)"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key.Name, value, valueSource(settings, key), key.Env)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		return envErr
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a key in settings.yaml",
+	Long: `Sets a key in settings.yaml. Lists take comma-separated values, and an
+empty value ("") unsets a key so its default is used.
+
+  courtside config set refresh.live_list 15s
+  courtside config set favorite_teams BOS,NYK`,
+	Args:          cobra.ExactArgs(2),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := data.ReadSettings()
+		if err != nil {
+			return fmt.Errorf("%w\nfix it with \"courtside config edit\" first", err)
+		}
+		if err := settings.Set(args[0], args[1]); err != nil {
+			return err
+		}
+		return data.SaveSettings(settings)
+	},
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open settings.yaml in $VISUAL or $EDITOR, then validate it",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := data.SettingsPath()
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if err := data.SaveSettings(&data.Settings{}); err != nil {
+				return err
+			}
+		}
+
+		editor := strings.Fields(editorCommand())
+		editorCmd := exec.Command(editor[0], append(editor[1:], path)...)
+		editorCmd.Stdin = os.Stdin
+		editorCmd.Stdout = os.Stdout
+		editorCmd.Stderr = os.Stderr
+		if err := editorCmd.Run(); err != nil {
+			return fmt.Errorf("run editor: %w", err)
+		}
+
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		return validateSettingsFile(path)
+	},
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check settings.yaml and the environment overrides",
+	Long: `Checks settings.yaml for YAML errors, unknown keys, values of the wrong
+type and values out of range, and the COURTSIDE_* environment variables for
+values that don't parse. Exits with status 1 if there is a problem.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := data.SettingsPath()
+		if err != nil {
+			return err
+		}
+		return validateSettingsFile(path)
+	},
+}
+
+// validateSettingsFile checks the settings file at path and the environment
+// overrides, printing the result.
+func validateSettingsFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	fileErr := data.CheckSettings(content)
+
+	var envErr error
+	if fileErr == nil {
+		settings, _ := data.ReadSettings()
+		_, envErr = settings.Effective()
+	}
+
+	if err := errors.Join(fileErr, envErr); err != nil {
+		return fmt.Errorf("%s is not valid:\n%w", path, err)
+	}
+	fmt.Printf("%s is valid\n", path)
+	return nil
+}
+
+// valueSource returns where the value in effect for key comes from.
+func valueSource(settings *data.Settings, key data.ConfigKey) string {
+	if v, ok := os.LookupEnv(key.Env); ok && v != "" {
+		return "environment"
+	}
+	if v, _ := settings.Get(key.Name); v != "" && v != "false" {
+		return "settings.yaml"
+	}
+	return "default"
+}
+
+// editorCommand returns the user's editor command, or the platform's basic one.
+func editorCommand() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+func init() {
+	configCmd.AddCommand(configPathCmd, configGetCmd, configSetCmd, configEditCmd, configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+}
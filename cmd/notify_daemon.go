@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/daemon"
+	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/nba"
+	"github.com/gabriel7419/courtside/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+var daemonInterval time.Duration
+
+var notifyDaemonCmd = &cobra.Command{
+	Use:   "notify-daemon",
+	Short: "Send notifications for tonight's games without the TUI",
+	Long: `Watches the scoreboard in the background and sends the configured notifications
+for every live game, and for favorite teams from tip-off, until the night's games end.
+Notification rules and favorite teams are read from settings.yaml.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		lockPath, err := daemon.LockPath()
+		if err != nil {
+			return err
+		}
+		release, err := daemon.AcquireLock(lockPath)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		logger := log.New(os.Stderr, "notify-daemon: ", log.LstdFlags)
+
+		// As in the app, a settings.yaml that doesn't parse means the defaults,
+		// and environment overrides that don't are skipped: the daemon reports
+		// them and carries on
+		settings, err := data.ReadSettings()
+		if err != nil {
+			logger.Printf("settings: %v (using the defaults)", err)
+			settings = &data.Settings{}
+		} else if err := settings.Validate(); err != nil {
+			logger.Printf("settings: %v", err)
+		}
+		settings, err = settings.Effective()
+		if err != nil {
+			logger.Printf("settings from the environment: %v", err)
+		}
+		interval := daemonInterval
+		if interval <= 0 {
+			interval = settings.Refresh.Daemon
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		notifier, err := notify.NewNotifier(notify.NewDesktopNotifier(), settings)
+		if err != nil {
+			logger.Printf("notification sinks: %v", err)
+		}
+		dispatcher := notify.NewDispatcher(notifier, settings)
+		if path, err := notify.HistoryPath(); err == nil {
+			dispatcher.SetHistory(notify.NewHistory(path))
+		}
+		client := nba.NewClientWithConfig(nba.ConfigFromSettings(settings.NBA))
+		d := daemon.New(client, dispatcher, settings.FavoriteTeams, interval, logger.Printf)
+		if path, err := notify.RemindersPath(); err == nil {
+			d.SetReminders(notify.NewReminders(path), settings.Notifications.ReminderMinutes)
+		}
+
+		logger.Printf("started (pid %d, polling every %s)", os.Getpid(), interval)
+		err = d.Run(ctx)
+		logger.Printf("stopped")
+		return err
+	},
+}
+
+func init() {
+	notifyDaemonCmd.Flags().DurationVar(&daemonInterval, "interval", 0, "Time between scoreboard polls (default refresh.daemon in settings.yaml, 30s)")
+	rootCmd.AddCommand(notifyDaemonCmd)
+}
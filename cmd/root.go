@@ -23,10 +23,16 @@ var updateFlag bool
 var versionFlag bool
 var debugFlag bool
 
+// migration lists the Golazo directories found on the first run after the move.
+var migration *data.Migration
+
 var rootCmd = &cobra.Command{
 	Use:   "courtside",
 	Short: "NBA live scores in your terminal",
 	Long:  `A minimal TUI for following NBA games in real-time. Get live scores, quarter-by-quarter breakdowns, play-by-play events, box scores, and standings directly in your terminal.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		migrateFromGolazo()
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag {
 			version.Print(Version)
@@ -65,7 +71,7 @@ var rootCmd = &cobra.Command{
 			}
 		}()
 
-		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version), tea.WithAltScreen())
+		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, migration != nil, Version), tea.WithAltScreen())
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 			os.Exit(1)
@@ -73,6 +79,24 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// migrateFromGolazo tells the user, on the first run after the move, that
+// courtside no longer uses the directories it shared with Golazo and that
+// nothing in them was carried over.
+func migrateFromGolazo() {
+	var err error
+	migration, err = data.MigrateFromGolazo()
+	if migration != nil {
+		fmt.Fprintln(os.Stderr, "Courtside now keeps its files in its own directories instead of Golazo's.")
+		fmt.Fprintln(os.Stderr, "Nothing was carried over; the football league selection and caches stay for Golazo in:")
+		for _, dir := range migration.LegacyDirs {
+			fmt.Fprintf(os.Stderr, "  %s\n", dir)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not check for the Golazo directories: %v\n", err)
+	}
+}
+
 // runUpdate executes the appropriate update method based on installation detection.
 func runUpdate() {
 	installMethod := detectInstallationMethod()
@@ -172,7 +196,7 @@ func Execute() {
 
 func init() {
 	rootCmd.Flags().BoolVar(&mockFlag, "mock", false, "Use mock data for all views instead of real API data")
-	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to ~/.golazo/golazo_debug.log")
+	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to courtside_debug.log in the state directory")
 	rootCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Update golazo to the latest version")
 	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display version information")
 }
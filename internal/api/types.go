@@ -46,11 +46,12 @@ type Match struct {
 	PageURL   string      `json:"page_url,omitempty"`
 
 	// NBA-specific fields
-	Quarter       *int    `json:"quarter,omitempty"` // 1-4, 5+ = OT
-	Clock         *string `json:"clock,omitempty"`   // "2:34"
-	IsPlayoffs    bool    `json:"is_playoffs,omitempty"`
-	SeriesStatus  *string `json:"series_status,omitempty"`  // "Series tied 2-2"
-	QuarterScores []int   `json:"quarter_scores,omitempty"` // [Q1home, Q1away, Q2home, Q2away, ...] cached from scoreboard
+	Quarter       *int     `json:"quarter,omitempty"` // 1-4, 5+ = OT
+	Clock         *string  `json:"clock,omitempty"`   // "2:34"
+	IsPlayoffs    bool     `json:"is_playoffs,omitempty"`
+	SeriesStatus  *string  `json:"series_status,omitempty"`  // "Series tied 2-2"
+	QuarterScores []int    `json:"quarter_scores,omitempty"` // [Q1home, Q1away, Q2home, Q2away, ...] cached from scoreboard
+	Broadcasters  []string `json:"broadcasters,omitempty"`   // TV networks, national first ("ESPN", "NBCS-BOS")
 }
 
 // MatchEvent represents an event during a match (goal, card, field goal, foul, etc.).
@@ -69,7 +70,8 @@ type MatchEvent struct {
 	// NBA-specific fields
 	Points       *int    `json:"points,omitempty"`        // 1 (free throw), 2, or 3 (field goal)
 	IsThree      *bool   `json:"is_three,omitempty"`      // whether it was a 3-pointer
-	EventSubtype *string `json:"event_subtype,omitempty"` // "personal", "technical", "flagrant"
+	EventSubtype *string `json:"event_subtype,omitempty"` // "personal", "technical", "flagrant"; shot type for field goals
+	PlayID       int     `json:"play_id,omitempty"`       // for events derived from a play, such as milestones, the play's ID
 }
 
 // MatchStatistic represents a single statistic entry (possession, FG%, rebounds, etc.).
@@ -142,6 +144,7 @@ type MatchDetails struct {
 
 // PlayerStatLine holds individual player statistics from an NBA box score.
 type PlayerStatLine struct {
+	PlayerID  int    `json:"player_id,omitempty"`
 	Name      string `json:"name"`
 	Position  string `json:"position,omitempty"` // G, F, C
 	Minutes   string `json:"minutes,omitempty"`  // "32:14"
@@ -157,6 +160,7 @@ type PlayerStatLine struct {
 	FTM       int    `json:"ftm"`  // free throws made
 	FTA       int    `json:"fta"`  // free throws attempted
 	PlusMinus int    `json:"plus_minus"`
+	Starter   bool   `json:"starter,omitempty"`
 }
 
 // MatchHighlight represents a highlight video link.
@@ -184,3 +188,141 @@ type LeagueTableEntry struct {
 	Form           string `json:"form,omitempty"` // e.g. "W3", "L2" for NBA; "WWDLL" for football
 	Note           string `json:"note,omitempty"` // e.g. "East | GB: 3.5"
 }
+
+// TeamProfile aggregates a team's season: schedule, roster and per-game averages.
+type TeamProfile struct {
+	Team     Team           `json:"team"`
+	Season   string         `json:"season"` // "2025-26"
+	Wins     int            `json:"wins"`
+	Losses   int            `json:"losses"`
+	Schedule []Match        `json:"schedule,omitempty"` // every game of the season in date order
+	Roster   []RosterPlayer `json:"roster,omitempty"`
+	Averages []TeamStatRank `json:"averages,omitempty"`
+}
+
+// RosterPlayer is a player on a team's current roster.
+type RosterPlayer struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Number     string `json:"number,omitempty"`   // jersey number as listed ("0", "00")
+	Position   string `json:"position,omitempty"` // "G", "F-C"
+	Height     string `json:"height,omitempty"`   // "6-8"
+	Weight     string `json:"weight,omitempty"`   // pounds
+	Age        int    `json:"age,omitempty"`
+	Experience string `json:"experience,omitempty"` // years in the league, "R" for rookies
+	School     string `json:"school,omitempty"`
+}
+
+// TeamStatRank is a per-game team average with its league rank (1 = best).
+type TeamStatRank struct {
+	Key   string  `json:"key"`   // e.g., "pts", "fg_pct"
+	Label string  `json:"label"` // e.g., "Points", "FG%"
+	Value float64 `json:"value"` // percentages are fractions (0.471)
+	Rank  int     `json:"rank"`
+}
+
+// PlayerProfile holds a player's bio, current-season averages and recent game log.
+type PlayerProfile struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Team       Team   `json:"team"`
+	Position   string `json:"position,omitempty"` // "Forward", "Guard-Forward"
+	Jersey     string `json:"jersey,omitempty"`
+	Height     string `json:"height,omitempty"` // "6-8"
+	Weight     string `json:"weight,omitempty"` // pounds
+	Age        int    `json:"age,omitempty"`
+	Country    string `json:"country,omitempty"`
+	School     string `json:"school,omitempty"`
+	Experience int    `json:"experience,omitempty"` // seasons in the league
+	Draft      string `json:"draft,omitempty"`      // "2017 R1 #3" or "Undrafted"
+
+	Season   string                `json:"season"` // "2025-26"
+	Averages *PlayerSeasonAverages `json:"averages,omitempty"`
+	GameLog  []PlayerGameLogEntry  `json:"game_log,omitempty"` // most recent first
+}
+
+// PlayerSeasonAverages holds per-game averages and shooting splits for one season.
+// Percentages are fractions (0.471).
+type PlayerSeasonAverages struct {
+	GamesPlayed  int     `json:"games_played"`
+	GamesStarted int     `json:"games_started"`
+	Minutes      float64 `json:"minutes"`
+	Points       float64 `json:"points"`
+	Rebounds     float64 `json:"rebounds"`
+	Assists      float64 `json:"assists"`
+	Steals       float64 `json:"steals"`
+	Blocks       float64 `json:"blocks"`
+	Turnovers    float64 `json:"turnovers"`
+	FGM          float64 `json:"fgm"`
+	FGA          float64 `json:"fga"`
+	FGPct        float64 `json:"fg_pct"`
+	FG3M         float64 `json:"fg3m"`
+	FG3A         float64 `json:"fg3a"`
+	FG3Pct       float64 `json:"fg3_pct"`
+	FTM          float64 `json:"ftm"`
+	FTA          float64 `json:"fta"`
+	FTPct        float64 `json:"ft_pct"`
+}
+
+// PlayerGameLogEntry is one game from a player's game log.
+type PlayerGameLogEntry struct {
+	MatchID   int       `json:"match_id"` // same numeric ID as Match.ID
+	Date      time.Time `json:"date"`
+	Matchup   string    `json:"matchup"` // "BOS vs. NYK" or "BOS @ NYK"
+	Result    string    `json:"result"`  // "W" or "L"
+	Minutes   int       `json:"minutes"`
+	Points    int       `json:"points"`
+	Rebounds  int       `json:"rebounds"`
+	Assists   int       `json:"assists"`
+	FGM       int       `json:"fgm"`
+	FGA       int       `json:"fga"`
+	FG3M      int       `json:"fg3m"`
+	FG3A      int       `json:"fg3a"`
+	FTM       int       `json:"ftm"`
+	FTA       int       `json:"fta"`
+	PlusMinus int       `json:"plus_minus"`
+}
+
+// LeagueLeader is one row of a league leaders table.
+// Value, Minutes, Made and Attempted follow the table's mode (per game, totals or per 36).
+type LeagueLeader struct {
+	Rank        int     `json:"rank"`
+	PlayerID    int     `json:"player_id"`
+	Name        string  `json:"name"`
+	Team        Team    `json:"team"` // ID and ShortName only
+	GamesPlayed int     `json:"games_played"`
+	Minutes     float64 `json:"minutes"`
+	Value       float64 `json:"value"`               // category value; percentages are fractions (0.471)
+	Made        float64 `json:"made,omitempty"`      // shooting categories only
+	Attempted   float64 `json:"attempted,omitempty"` // shooting categories only
+}
+
+// GamePreview is the pre-game card for a scheduled game.
+type GamePreview struct {
+	MatchID      int         `json:"match_id"`
+	Home         PreviewTeam `json:"home"`
+	Away         PreviewTeam `json:"away"`
+	SeasonSeries []Match     `json:"season_series,omitempty"` // this season's meetings in date order, including upcoming ones
+}
+
+// PreviewTeam is one side of a GamePreview.
+type PreviewTeam struct {
+	Team           Team            `json:"team"`
+	Wins           int             `json:"wins"`
+	Losses         int             `json:"losses"`
+	Conference     string          `json:"conference,omitempty"` // "East" or "West"
+	ConferenceRank int             `json:"conference_rank,omitempty"`
+	Streak         string          `json:"streak,omitempty"`      // "W3", "L2"
+	LastFive       []Match         `json:"last_five,omitempty"`   // most recent first
+	Starters       []string        `json:"starters,omitempty"`    // starting five of the team's previous game
+	TopScorers     []PreviewScorer `json:"top_scorers,omitempty"` // by season points per game
+}
+
+// PreviewScorer is a player's season per-game line shown in a game preview.
+type PreviewScorer struct {
+	PlayerID int     `json:"player_id"`
+	Name     string  `json:"name"`
+	Points   float64 `json:"points"`
+	Rebounds float64 `json:"rebounds"`
+	Assists  float64 `json:"assists"`
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/nba"
+)
+
+// CatchUpMinAway is how long a game must have been out of sight for the
+// catch-up card to show when it is opened again.
+const CatchUpMinAway = 2 * time.Minute
+
+// trackLastSeen shows the catch-up card when a game is opened in the live
+// view after a while away, then records its latest play as seen.
+func (m *model) trackLastSeen(details *api.MatchDetails) {
+	if details.ID != m.lastSeenMatchID {
+		m.lastSeenMatchID = details.ID
+		m.catchUp = nil
+		if seen, ok := data.LoadLastSeen(details.ID); ok && time.Since(seen.Time) >= CatchUpMinAway {
+			m.catchUp = nba.NewCatchUp(details, seen.EventID, seen.HomeScore, seen.AwayScore)
+		}
+	}
+
+	seen := data.LastSeen{Time: time.Now()}
+	for _, ev := range details.Events {
+		seen.EventID = max(seen.EventID, ev.ID)
+	}
+	if details.HomeScore != nil && details.AwayScore != nil {
+		seen.HomeScore, seen.AwayScore = *details.HomeScore, *details.AwayScore
+	}
+	if err := data.SaveLastSeen(details.ID, seen); err != nil {
+		m.debugLog(fmt.Sprintf("last seen: %v", err))
+	}
+}
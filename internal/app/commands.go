@@ -2,18 +2,18 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/gabriel7419/courtside/internal/api"
 	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/highlights"
 	"github.com/gabriel7419/courtside/internal/nba"
+	"github.com/gabriel7419/courtside/internal/notify"
 	"github.com/gabriel7419/courtside/internal/reddit"
 )
 
-// LiveRefreshInterval is the interval between automatic live game list refreshes.
-const LiveRefreshInterval = 30 * time.Second
-
 // LiveBatchSize is kept for compatibility with the progressive load message types.
 // For NBA, the scoreboard returns all games in a single call, so we always use 1 batch.
 const LiveBatchSize = 1
@@ -55,9 +55,10 @@ func fetchLiveBatchData(client *nba.Client, useMockData bool, batchIndex int) te
 	}
 }
 
-// scheduleLiveRefresh schedules the next live game list refresh.
-func scheduleLiveRefresh(client *nba.Client, useMockData bool) tea.Cmd {
-	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
+// scheduleLiveRefresh schedules the next live game list refresh after interval
+// (refresh.live_list in settings).
+func scheduleLiveRefresh(client *nba.Client, useMockData bool, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		if useMockData {
 			return liveRefreshMsg{matches: data.MockNBALiveMatches()}
 		}
@@ -118,9 +119,10 @@ func fetchMatchDetailsForceRefresh(client *nba.Client, matchID int, useMockData
 	}
 }
 
-// schedulePollTick schedules the next poll after 30 seconds (NBA games update quickly).
-func schedulePollTick(matchID int) tea.Cmd {
-	return tea.Tick(30*time.Second, func(t time.Time) tea.Msg {
+// schedulePollTick schedules the next poll of a live game after interval
+// (refresh.game in settings).
+func schedulePollTick(matchID int, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		return pollTickMsg{matchID: matchID}
 	})
 }
@@ -239,62 +241,232 @@ func fetchStatsMatchDetails(client *nba.Client, matchID int, useMockData bool) t
 	}
 }
 
-// fetchGoalLinks fetches highlight links from Reddit for a game's scoring events.
-func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
+// fetchScheduleDay fetches every game on the given day for the schedule view.
+// day is a local calendar day; it is passed to MatchesByDate as local noon so
+// the UTC date the API sees matches the day the user picked.
+func fetchScheduleDay(client *nba.Client, useMockData bool, day time.Time) tea.Cmd {
 	return func() tea.Msg {
-		if redditClient == nil || details == nil {
-			return goalLinksMsg{matchID: 0}
+		key := day.Format("2006-01-02")
+
+		if useMockData {
+			return scheduleDayMsg{day: key, matches: data.MockNBAScheduleMatches(day)}
+		}
+		if client == nil {
+			return scheduleDayMsg{day: key}
 		}
 
-		var goals []reddit.GoalInfo
-		for _, event := range details.Events {
-			if event.Type != "field_goal" && event.Type != "goal" {
-				continue
-			}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
 
-			scorer := ""
-			if event.Player != nil {
-				scorer = *event.Player
-			}
+		matches, err := client.MatchesByDate(ctx, day)
+		if err != nil {
+			return scheduleDayMsg{day: key, err: err}
+		}
+		return scheduleDayMsg{day: key, matches: matches}
+	}
+}
 
-			isHome := event.Team.ID == details.HomeTeam.ID
+// fetchGamePreview fetches the pre-game preview of a scheduled game.
+func fetchGamePreview(client *nba.Client, useMockData bool, match api.Match) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			preview, err := data.MockNBAGamePreview(match)
+			return gamePreviewMsg{matchID: match.ID, preview: preview, err: err}
+		}
+		if client == nil {
+			return gamePreviewMsg{matchID: match.ID}
+		}
 
-			homeScore := 0
-			if details.HomeScore != nil {
-				homeScore = *details.HomeScore
-			}
-			awayScore := 0
-			if details.AwayScore != nil {
-				awayScore = *details.AwayScore
-			}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
 
-			matchTime := time.Now()
-			if details.MatchTime != nil {
-				matchTime = *details.MatchTime
-			}
+		preview, err := client.GamePreview(ctx, match)
+		return gamePreviewMsg{matchID: match.ID, preview: preview, err: err}
+	}
+}
 
-			goals = append(goals, reddit.GoalInfo{
-				MatchID:       details.ID,
-				HomeTeam:      details.HomeTeam.Name,
-				AwayTeam:      details.AwayTeam.Name,
-				HomeTeamShort: details.HomeTeam.ShortName,
-				AwayTeamShort: details.AwayTeam.ShortName,
-				ScorerName:    scorer,
-				Minute:        event.Minute,
-				DisplayMinute: event.DisplayMinute,
-				HomeScore:     homeScore,
-				AwayScore:     awayScore,
-				IsHomeTeam:    isHome,
-				MatchTime:     matchTime,
+// TipOffRecheckInterval is how often a game past its tip-off time is checked
+// until the scoreboard reports it as started.
+const TipOffRecheckInterval = 60 * time.Second
+
+// scheduleTipOffCheck sends a tipOffMsg at the game's tip-off time,
+// or after TipOffRecheckInterval if that time has already passed.
+func scheduleTipOffCheck(match api.Match) tea.Cmd {
+	wait := TipOffRecheckInterval
+	if match.MatchTime != nil {
+		if until := time.Until(*match.MatchTime); until > wait {
+			wait = until
+		}
+	}
+	return tea.Tick(wait, func(t time.Time) tea.Msg {
+		return tipOffMsg{matchID: match.ID}
+	})
+}
+
+// ReminderCheckInterval is how often the app looks for tip-off reminders to fire.
+const ReminderCheckInterval = 30 * time.Second
+
+// scheduleReminderCheck sends a reminderTickMsg after ReminderCheckInterval.
+func scheduleReminderCheck() tea.Cmd {
+	return tea.Tick(ReminderCheckInterval, func(t time.Time) tea.Msg {
+		return reminderTickMsg{}
+	})
+}
+
+// checkReminders fires the reminders that are due through the dispatcher,
+// which applies quiet hours and snooze like any other notification.
+func checkReminders(reminders *notify.Reminders, dispatcher *notify.Dispatcher, minutes int) tea.Cmd {
+	if reminders == nil || dispatcher == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		err := reminders.Fire(time.Now(), minutes, func(rem notify.Reminder) error {
+			return dispatcher.Send(notify.NewReminderEvent(rem, time.Now()))
+		})
+		if err != nil {
+			return debugLogMsg{message: fmt.Sprintf("reminders: %v", err)}
+		}
+		return nil
+	}
+}
+
+// fetchScheduleMatchDetails fetches game details for the schedule view.
+// The scoreboard entry is passed as fallback since days outside the recent
+// window are not found by MatchFromCache.
+func fetchScheduleMatchDetails(client *nba.Client, match api.Match, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details, _ := data.MockNBAMatchDetails(match.ID)
+			return matchDetailsMsg{details: details}
+		}
+		if client == nil {
+			return matchDetailsMsg{}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
+		defer cancel()
+
+		details, err := client.MatchDetails(ctx, match.ID, &match)
+		if err != nil {
+			return matchDetailsMsg{}
+		}
+		return matchDetailsMsg{details: details}
+	}
+}
+
+// fetchHighlights asks the highlight providers for clips of a game's notable plays.
+func fetchHighlights(providers *highlights.Providers, details *api.MatchDetails, plays []nba.NotablePlay) tea.Cmd {
+	return func() tea.Msg {
+		if providers == nil || details == nil {
+			return highlightsMsg{matchID: 0}
+		}
+
+		matchTime := time.Now()
+		if details.MatchTime != nil {
+			matchTime = *details.MatchTime
+		}
+
+		infos := make([]reddit.PlayInfo, 0, len(plays))
+		for _, play := range plays {
+			infos = append(infos, reddit.PlayInfo{
+				MatchID:    details.ID,
+				EventID:    play.Event.ID,
+				Period:     play.Period,
+				Clock:      play.Clock,
+				HomeTeam:   details.HomeTeam.Name,
+				AwayTeam:   details.AwayTeam.Name,
+				Player:     *play.Event.Player,
+				Kind:       play.Kind,
+				Detail:     play.Detail,
+				HomeScore:  play.HomeScore,
+				AwayScore:  play.AwayScore,
+				IsHomeTeam: play.Home,
+				MatchTime:  matchTime,
 			})
 		}
 
-		if len(goals) == 0 {
-			return goalLinksMsg{matchID: details.ID}
+		clips, err := providers.Find(infos)
+		return highlightsMsg{matchID: details.ID, clips: clips, err: err}
+	}
+}
+
+// fetchGameThreads finds a game's r/nba game thread and post-game thread.
+func fetchGameThreads(redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		matchTime := time.Now()
+		if details.MatchTime != nil {
+			matchTime = *details.MatchTime
+		}
+		threads, err := redditClient.GameThreads(reddit.ThreadInfo{
+			MatchID:   details.ID,
+			HomeTeam:  details.HomeTeam.Name,
+			AwayTeam:  details.AwayTeam.Name,
+			MatchTime: matchTime,
+			Finished:  details.Status == api.MatchStatusFinished,
+		})
+		if err != nil {
+			return gameThreadsMsg{matchID: details.ID}
+		}
+		return gameThreadsMsg{matchID: details.ID, threads: threads}
+	}
+}
+
+// fetchTeamProfile fetches a team's schedule, roster and season averages.
+func fetchTeamProfile(client *nba.Client, useMockData bool, teamID int) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			profile, err := data.MockNBATeamProfile(teamID)
+			return teamProfileMsg{teamID: teamID, profile: profile, err: err}
+		}
+		if client == nil {
+			return teamProfileMsg{teamID: teamID}
+		}
+
+		// The season schedule is a large response; allow more time than a scoreboard
+		ctx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
+		defer cancel()
+
+		profile, err := client.TeamProfile(ctx, teamID)
+		return teamProfileMsg{teamID: teamID, profile: profile, err: err}
+	}
+}
+
+// fetchPlayerProfile fetches a player's bio, season averages and game log.
+// name and matchID are only used by mock data.
+func fetchPlayerProfile(client *nba.Client, useMockData bool, playerID int, name string, matchID int, playoffs bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			profile, err := data.MockNBAPlayerProfile(playerID, name, matchID)
+			return playerProfileMsg{playerID: playerID, matchID: matchID, profile: profile, err: err}
+		}
+		if client == nil {
+			return playerProfileMsg{playerID: playerID, matchID: matchID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		profile, err := client.PlayerProfile(ctx, playerID, playoffs)
+		return playerProfileMsg{playerID: playerID, matchID: matchID, profile: profile, err: err}
+	}
+}
+
+// fetchLeaders fetches a league leaders table.
+// Mock data ignores the season and season type.
+func fetchLeaders(client *nba.Client, useMockData bool, query nba.LeadersQuery) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return leadersMsg{query: query, leaders: data.MockNBALeagueLeaders(query.Category, query.Mode)}
+		}
+		if client == nil {
+			return leadersMsg{query: query}
 		}
 
-		links := redditClient.GoalLinks(goals)
-		return goalLinksMsg{matchID: details.ID, links: links}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		leaders, err := client.LeagueLeaders(ctx, query)
+		return leadersMsg{query: query, leaders: leaders, err: err}
 	}
 }
 
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/reddit"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// maxThreadComments is how many of the newest comments the panel keeps.
+const maxThreadComments = 20
+
+// fetchComments fetches the newest comments of a game's thread.
+func fetchComments(redditClient *reddit.Client, matchID int, postURL string) tea.Cmd {
+	return func() tea.Msg {
+		comments, err := redditClient.Comments(postURL, maxThreadComments)
+		return commentsMsg{matchID: matchID, comments: comments, err: err}
+	}
+}
+
+// scheduleCommentsTick sends a commentsTickMsg after interval (refresh.comments
+// in settings).
+func scheduleCommentsTick(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return commentsTickMsg{}
+	})
+}
+
+// toggleComments opens or closes the game thread comments panel of the live
+// view, starting the poll unless it is still running.
+func (m model) toggleComments() (tea.Model, tea.Cmd) {
+	m.showComments = !m.showComments
+	if !m.showComments || m.commentsPolling {
+		return m, nil
+	}
+	m.commentsPolling = true
+	return m, m.pollComments()
+}
+
+// pollComments returns the command fetching the comments of the live game's
+// thread. Until the thread is found it waits for the next tick instead.
+func (m model) pollComments() tea.Cmd {
+	if m.redditClient == nil || m.matchDetails == nil {
+		return scheduleCommentsTick(m.settings.Refresh.Comments)
+	}
+	threads := m.gameThreads[m.matchDetails.ID]
+	if threads == nil || threads.GameThread == "" {
+		return scheduleCommentsTick(m.settings.Refresh.Comments)
+	}
+	return fetchComments(m.redditClient, m.matchDetails.ID, threads.GameThread)
+}
+
+// handleCommentsTick polls the comments again while the panel is open in the
+// live view, and ends the poll otherwise.
+func (m model) handleCommentsTick() (tea.Model, tea.Cmd) {
+	if !m.showComments || m.currentView != viewLiveMatches {
+		m.commentsPolling = false
+		return m, nil
+	}
+	return m, m.pollComments()
+}
+
+// handleComments replaces the comments of the live game with the newest ones,
+// keeping the previous ones on error, and schedules the next poll.
+func (m model) handleComments(msg commentsMsg) (tea.Model, tea.Cmd) {
+	if m.matchDetails != nil && msg.matchID == m.matchDetails.ID {
+		if msg.matchID != m.commentsMatchID {
+			m.comments = nil
+		}
+		m.commentsMatchID = msg.matchID
+		m.commentsErr = msg.err
+		if msg.err == nil {
+			m.comments = msg.comments
+		}
+	}
+
+	if !m.showComments || m.currentView != viewLiveMatches {
+		m.commentsPolling = false
+		return m, nil
+	}
+	return m, scheduleCommentsTick(m.settings.Refresh.Comments)
+}
+
+// commentsPanel returns the content of the comments panel, or nil when it is
+// closed.
+func (m model) commentsPanel() *ui.CommentsPanel {
+	if !m.showComments {
+		return nil
+	}
+	panel := &ui.CommentsPanel{}
+	if m.matchDetails == nil {
+		return panel
+	}
+
+	if m.commentsMatchID == m.matchDetails.ID {
+		for _, c := range m.comments {
+			panel.Comments = append(panel.Comments, ui.ThreadComment{
+				Author: c.Author,
+				Body:   c.Body,
+				Score:  c.Score,
+				Time:   c.CreatedAt,
+			})
+		}
+	}
+
+	switch threads := m.gameThreads[m.matchDetails.ID]; {
+	case threads == nil || threads.GameThread == "":
+		panel.Status = constants.StatusFindingThread
+	case m.commentsMatchID != m.matchDetails.ID:
+		panel.Status = constants.StatusCommentsLoading
+	case errors.Is(m.commentsErr, reddit.ErrBlocked):
+		panel.Status = constants.StatusCommentsBlocked
+	case m.commentsErr != nil:
+		panel.Status = constants.StatusCommentsFailed
+	}
+	return panel
+}
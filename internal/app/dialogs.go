@@ -0,0 +1,127 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// handleGameDialogKeys opens dialogs for the game shown in the details panel:
+// "t"/"T" the home/away team page, "b" the full box score and "v" the
+// highlights reel; "o" opens its r/nba thread in the browser. Returns false
+// if the key is not one of these.
+func (m model) handleGameDialogKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	var match *api.Match
+	if m.matchDetails != nil {
+		match = &m.matchDetails.Match
+	} else if m.currentView == viewSchedule {
+		match = m.selectedScheduleMatch()
+	}
+	if match == nil {
+		return m, nil, false
+	}
+
+	switch msg.String() {
+	case "t":
+		updated, cmd := m.openTeam(match.HomeTeam.ID)
+		return updated, cmd, true
+	case "T":
+		updated, cmd := m.openTeam(match.AwayTeam.ID)
+		return updated, cmd, true
+	case "b":
+		m.openBoxScoreDialog()
+		return m, nil, true
+	case "v":
+		if m.matchDetails == nil {
+			return m, nil, false
+		}
+		m.openHighlightsDialog()
+		return m, nil, true
+	case "o":
+		// The post-game thread once there is one, the game thread before
+		links := m.threadLinks()
+		if links == nil {
+			return m, nil, false
+		}
+		url := links.PostGameThread
+		if url == "" {
+			url = links.GameThread
+		}
+		if err := ui.OpenURL(url); err != nil {
+			m.debugLog(fmt.Sprintf("open thread: %v", err))
+		}
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+// handleDialogAction applies an action returned by the front dialog.
+func (m model) handleDialogAction(action ui.DialogAction) (tea.Model, tea.Cmd) {
+	switch action := action.(type) {
+	case ui.DialogActionClose:
+		m.dialogOverlay.CloseFrontDialog()
+	case ui.DialogActionOpenTeam:
+		return m.openTeam(action.TeamID)
+	case ui.DialogActionOpenPlayer:
+		return m.openPlayer(action.PlayerID, action.Name)
+	case ui.DialogActionOpenMatch:
+		m.dialogOverlay.CloseAllDialogs()
+		return m.openMatchInSchedule(action.Match)
+	case ui.DialogActionMarkRead:
+		if m.notifyHistory != nil {
+			if err := m.notifyHistory.MarkRead(action.IDs...); err != nil {
+				m.debugLog(fmt.Sprintf("notify: %v", err))
+			}
+		}
+		if action.Match != nil {
+			m.dialogOverlay.CloseAllDialogs()
+			return m.openMatchInSchedule(*action.Match)
+		}
+	case ui.DialogActionOpenURL:
+		if err := ui.OpenURL(action.URL); err != nil {
+			m.debugLog(fmt.Sprintf("open clip: %v", err))
+		}
+	case ui.DialogActionCopyURL:
+		if dialog, ok := m.dialogOverlay.FrontDialog().(*ui.HighlightsDialog); ok {
+			if err := ui.CopyToClipboard(action.URL); err != nil {
+				dialog.SetStatus(fmt.Sprintf(constants.StatusCopyFailed, err))
+			} else {
+				dialog.SetStatus(constants.StatusLinkCopied)
+			}
+		}
+	case ui.DialogActionSearchHighlights:
+		cmd := m.searchMissingHighlights()
+		if dialog, ok := m.dialogOverlay.FrontDialog().(*ui.HighlightsDialog); ok && cmd != nil {
+			dialog.SetStatus(constants.StatusSearchingHighlights)
+		}
+		return m, cmd
+	}
+	return m, nil
+}
+
+// openInboxDialog opens the notification history, newest first.
+func (m *model) openInboxDialog() {
+	if m.dialogOverlay == nil {
+		return
+	}
+	var entries []ui.InboxEntry
+	if m.notifyHistory != nil {
+		history, err := m.notifyHistory.Entries()
+		if err != nil {
+			m.debugLog(fmt.Sprintf("notify: %v", err))
+		}
+		for _, h := range history {
+			entries = append(entries, ui.InboxEntry{
+				ID:      h.ID,
+				Time:    h.Time,
+				Message: h.Message,
+				Match:   h.Match(),
+				Read:    h.Read,
+			})
+		}
+	}
+	m.dialogOverlay.OpenDialog(ui.NewInboxDialog(entries))
+}
@@ -2,6 +2,8 @@ package app
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -16,20 +18,24 @@ import (
 func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "j", "down":
-		if m.selected < 2 && !m.mainViewLoading { // 3 menu items: 0, 1, 2
+		if m.selected < 4 && !m.mainViewLoading { // 5 menu items: 0, 1, 2, 3, 4
 			m.selected++
 		}
 	case "k", "up":
 		if m.selected > 0 && !m.mainViewLoading {
 			m.selected--
 		}
+	case "n":
+		if !m.mainViewLoading {
+			m.openInboxDialog()
+		}
 	case "enter":
 		if m.mainViewLoading {
 			return m, nil
 		}
 
 		// Handle Settings view separately (no API calls needed)
-		if m.selected == 2 {
+		if m.selected == 4 {
 			m.settingsState = ui.NewSettingsState()
 			m.currentView = viewSettings
 			return m, nil
@@ -44,8 +50,7 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.matchDetails = nil
 		m.liveUpdates = nil
 		m.lastEvents = nil
-		m.lastHomeScore = 0
-		m.lastAwayScore = 0
+		m.notifyDispatcher.Reset()
 		m.polling = false
 		m.upcomingMatchesList.SetItems([]list.Item{})
 		m.matchDetailsCache = make(map[int]*api.MatchDetails)
@@ -60,13 +65,13 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case 0: // Stats view - fetch data progressively (day by day)
 			m.statsViewLoading = true
 			m.loading = true
-			m.statsData = nil                          // Clear cached data to force fresh fetch
-			m.statsDaysLoaded = 0                      // Reset progress
-			m.statsTotalDays = nba.StatsDataDays       // Set total days to load
-			m.statsMatchesList.SetItems([]list.Item{}) // Clear list
+			m.statsData = nil                               // Clear cached data to force fresh fetch
+			m.statsDaysLoaded = 0                           // Reset progress
+			m.statsTotalDays = m.settings.Refresh.StatsDays // Set total days to load
+			m.statsMatchesList.SetItems([]list.Item{})      // Clear list
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching day 0 (today) first
-			cmds = append(cmds, fetchStatsDayData(m.nbaClient, m.useMockData, 0, nba.StatsDataDays))
+			cmds = append(cmds, fetchStatsDayData(m.nbaClient, m.useMockData, 0, m.settings.Refresh.StatsDays))
 		case 1: // Live Matches view - preload live matches progressively (parallel batches)
 			m.liveViewLoading = true
 			m.loading = true
@@ -76,6 +81,22 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.liveMatchesList.SetItems([]list.Item{})
 			cmds = append(cmds, ui.SpinnerTick())
 			cmds = append(cmds, fetchLiveBatchData(m.nbaClient, m.useMockData, 0))
+		case 2: // Schedule view - start on today, other days load lazily on navigation
+			m.scheduleDate = scheduleDay(time.Now())
+			m.scheduleLoading = true
+			m.loading = true
+			m.scheduleMatchesList.SetItems([]list.Item{})
+			cmds = append(cmds, ui.SpinnerTick())
+			cmds = append(cmds, fetchScheduleDay(m.nbaClient, m.useMockData, m.scheduleDate))
+		case 3: // Leaders view - current season per-game points, plus today's games for highlighting
+			m.leadersCategory = 0
+			m.leadersMode = nba.LeadersPerGame
+			m.leadersSeason = nba.CurrentSeason()
+			m.leadersPlayoffs = false
+			m.leadersCursor = 0
+			updated, cmd := m.loadLeaders()
+			m = updated.(model)
+			cmds = append(cmds, cmd, fetchScheduleDay(m.nbaClient, m.useMockData, scheduleDay(time.Now())))
 		}
 
 		return m, tea.Batch(cmds...)
@@ -89,25 +110,15 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "l", "right":
-		// Cycle date range forward: 1 -> 3 -> 5 -> 1
-		switch m.statsDateRange {
-		case 1:
-			m.statsDateRange = 3
-		case 3:
-			m.statsDateRange = 5
-		default:
-			m.statsDateRange = 1
-		}
+		// Cycle date range forward: 1 -> 3 -> 5 -> 1 (5 being refresh.stats_days)
+		ranges := ui.StatsDateRanges(m.statsTotalDays)
+		i := slices.Index(ranges, m.statsDateRange)
+		m.statsDateRange = ranges[(i+1)%len(ranges)]
 	case "h", "left":
 		// Cycle date range backward: 1 -> 5 -> 3 -> 1
-		switch m.statsDateRange {
-		case 1:
-			m.statsDateRange = 5
-		case 5:
-			m.statsDateRange = 3
-		default:
-			m.statsDateRange = 1
-		}
+		ranges := ui.StatsDateRanges(m.statsTotalDays)
+		i := max(slices.Index(ranges, m.statsDateRange), 0)
+		m.statsDateRange = ranges[(i+len(ranges)-1)%len(ranges)]
 	case "tab":
 		// Tab = toggle focus between left and right panels
 		m.statsRightPanelFocused = !m.statsRightPanelFocused
@@ -137,8 +148,8 @@ func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.statsViewLoading = true
 	m.loading = true
 	m.statsDaysLoaded = 0
-	m.statsTotalDays = nba.StatsDataDays
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.nbaClient, m.useMockData, 0, nba.StatsDataDays))
+	m.statsTotalDays = m.settings.Refresh.StatsDays
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.nbaClient, m.useMockData, 0, m.settings.Refresh.StatsDays))
 }
 
 // loadMatchDetails loads match details for the live matches view.
@@ -151,8 +162,7 @@ func (m model) loadMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 func (m model) loadMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.Model, tea.Cmd) {
 	m.liveUpdates = nil
 	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
+	m.notifyDispatcher.Reset()
 	m.loading = true
 	m.liveViewLoading = true
 	m.polling = false // Reset polling state - this is a new match load, not a poll refresh
@@ -214,14 +224,19 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.settingsState.Toggle()
 			return m, nil
 		case "right", "l": // Right arrow or 'l' to next tab
-			m.settingsState.NextRegion()
+			m.settingsState.NextTab()
 			return m, nil
 		case "left", "h": // Left arrow or 'h' to previous tab
-			m.settingsState.PreviousRegion()
+			m.settingsState.PreviousTab()
 			return m, nil
 		case "enter":
-			// Save settings and return to main menu
-			_ = m.settingsState.Save() // Best-effort save
+			// Save settings and return to main menu; the lists use them from now on
+			if err := m.settingsState.Save(); err != nil {
+				m.debugLog(fmt.Sprintf("save settings: %v", err))
+			} else {
+				settings, _ := m.settingsState.Settings().Effective()
+				m.applySettings(settings)
+			}
 			m.settingsState = nil
 			m.currentView = viewMain
 			m.selected = 0
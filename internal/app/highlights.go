@@ -0,0 +1,114 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/nba"
+	"github.com/gabriel7419/courtside/internal/notify"
+	"github.com/gabriel7419/courtside/internal/reddit"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// openHighlightsDialog opens the highlights reel of the current game: its
+// notable plays in game order with the clips found of each.
+func (m *model) openHighlightsDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+	details := m.matchDetails
+	dialog := ui.NewHighlightsDialog(details.AwayTeam.ShortName+" @ "+details.HomeTeam.ShortName, m.highlightReel(details.ID))
+	if m.highlightSearches[details.ID] {
+		dialog.SetStatus(constants.StatusSearchingHighlights)
+	}
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
+// highlightsDialog returns the open highlights reel when it is the front
+// dialog and shows the current game, or nil.
+func (m *model) highlightsDialog(matchID int) *ui.HighlightsDialog {
+	if m.dialogOverlay == nil || m.matchDetails == nil || m.matchDetails.ID != matchID {
+		return nil
+	}
+	dialog, _ := m.dialogOverlay.FrontDialog().(*ui.HighlightsDialog)
+	return dialog
+}
+
+// highlightReel lists a game's notable plays, oldest first, with the clips
+// found of each.
+func (m *model) highlightReel(matchID int) []ui.HighlightReelPlay {
+	plays := slices.Clone(m.notablePlays[matchID])
+	// Notable plays are newest first
+	slices.Reverse(plays)
+
+	reel := make([]ui.HighlightReelPlay, 0, len(plays))
+	for _, play := range plays {
+		entry := ui.HighlightReelPlay{
+			Time: notify.PeriodLabel(play.Period) + " " + play.Clock,
+			Play: playLabel(play),
+		}
+		for _, clip := range m.highlights[playKey(matchID, play)] {
+			entry.Clips = append(entry.Clips, ui.HighlightReelClip{
+				Title:   clip.Title,
+				URL:     clip.URL,
+				PostURL: clip.PostURL,
+				Source:  clip.Source,
+				Upvotes: clip.Upvotes,
+			})
+		}
+		reel = append(reel, entry)
+	}
+	return reel
+}
+
+// playLabel describes a notable play: "Jayson Tatum game-winner",
+// "Nikola Jokić 40 points".
+func playLabel(play nba.NotablePlay) string {
+	player := ""
+	if play.Event.Player != nil {
+		player = *play.Event.Player
+	}
+	what := play.Kind
+	if play.Kind == nba.PlayMilestone && play.Detail != "" {
+		what = play.Detail
+	}
+	if player == "" {
+		return what
+	}
+	return player + " " + what
+}
+
+// playKey returns the key the clips of a game's play are stored under.
+func playKey(matchID int, play nba.NotablePlay) reddit.PlayKey {
+	return reddit.PlayKey{MatchID: matchID, EventID: play.Event.ID, Period: play.Period, Clock: play.Clock}
+}
+
+// searchMissingHighlights has the providers look again for clips of the
+// current game's plays that have none, forgetting that earlier searches
+// found nothing.
+func (m *model) searchMissingHighlights() tea.Cmd {
+	details := m.matchDetails
+	if details == nil || m.highlightProviders == nil || m.useMockData || m.highlightSearches[details.ID] {
+		return nil
+	}
+
+	var missing []nba.NotablePlay
+	var keys []reddit.PlayKey
+	for _, play := range m.notablePlays[details.ID] {
+		key := playKey(details.ID, play)
+		if len(m.highlights[key]) == 0 {
+			missing = append(missing, play)
+			keys = append(keys, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if err := m.highlightProviders.Forget(keys); err != nil {
+		m.debugLog(fmt.Sprintf("Highlight providers: %v", err))
+	}
+	m.highlightSearches[details.ID] = true
+	return fetchHighlights(m.highlightProviders, details, missing)
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/nba"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// leadersModes is the order "m" cycles through.
+var leadersModes = []string{nba.LeadersPerGame, nba.LeadersTotals, nba.LeadersPer36}
+
+// leadersQuery returns the query for the table currently selected in the leaders view.
+func (m model) leadersQuery() nba.LeadersQuery {
+	seasonType := nba.SeasonTypeRegular
+	if m.leadersPlayoffs {
+		seasonType = nba.SeasonTypePlayoffs
+	}
+	return nba.LeadersQuery{
+		Category:   nba.LeaderCategories[m.leadersCategory],
+		Mode:       m.leadersMode,
+		Season:     m.leadersSeason,
+		SeasonType: seasonType,
+	}
+}
+
+// handleLeadersKeys processes keyboard input for the leaders view.
+// h/l switch category, m cycles the mode, [/] step the season and p toggles playoffs.
+func (m model) handleLeadersKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "h", "left":
+		m.leadersCategory = (m.leadersCategory + len(nba.LeaderCategories) - 1) % len(nba.LeaderCategories)
+		return m.loadLeaders()
+	case "l", "right":
+		m.leadersCategory = (m.leadersCategory + 1) % len(nba.LeaderCategories)
+		return m.loadLeaders()
+	case "m":
+		for i, mode := range leadersModes {
+			if mode == m.leadersMode {
+				m.leadersMode = leadersModes[(i+1)%len(leadersModes)]
+				break
+			}
+		}
+		return m.loadLeaders()
+	case "[":
+		m.leadersSeason = nba.PreviousSeason(m.leadersSeason)
+		return m.loadLeaders()
+	case "]":
+		// No leaders exist beyond the current season
+		if m.leadersSeason != nba.CurrentSeason() {
+			m.leadersSeason = nba.NextSeason(m.leadersSeason)
+			return m.loadLeaders()
+		}
+	case "p":
+		m.leadersPlayoffs = !m.leadersPlayoffs
+		return m.loadLeaders()
+	case "r":
+		return m.loadLeaders()
+	case "j", "down":
+		if m.leadersCursor < len(m.leaders)-1 {
+			m.leadersCursor++
+		}
+	case "k", "up":
+		if m.leadersCursor > 0 {
+			m.leadersCursor--
+		}
+	case "enter":
+		if m.leadersCursor < len(m.leaders) {
+			l := m.leaders[m.leadersCursor]
+			return m.openPlayer(l.PlayerID, l.Name)
+		}
+	}
+	return m, nil
+}
+
+// loadLeaders fetches the table for the current query. The client caches
+// tables, so switching back to one seen recently returns immediately.
+func (m model) loadLeaders() (tea.Model, tea.Cmd) {
+	m.leaders = nil
+	m.leadersCursor = 0
+	m.leadersFailed = false
+	m.leadersLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchLeaders(m.nbaClient, m.useMockData, m.leadersQuery()))
+}
+
+// handleLeaders shows a fetched leaders table if it still matches the selection.
+func (m model) handleLeaders(msg leadersMsg) (tea.Model, tea.Cmd) {
+	if msg.query != m.leadersQuery() {
+		return m, nil
+	}
+
+	m.leadersLoading = false
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("leaders: failed to load %+v: %v", msg.query, msg.err))
+		m.leadersFailed = true
+		return m, nil
+	}
+	m.leaders = msg.leaders
+	return m, nil
+}
+
+// teamsPlayingToday returns the IDs of teams with a game today that has not finished.
+// Uses today's scoreboard from the schedule cache, fetched when the leaders view opens.
+func (m model) teamsPlayingToday() map[int]bool {
+	teams := make(map[int]bool)
+	for _, match := range m.scheduleDays[scheduleDay(time.Now()).Format(scheduleDayKey)] {
+		if match.Status == api.MatchStatusFinished {
+			continue
+		}
+		teams[match.HomeTeam.ID] = true
+		teams[match.AwayTeam.ID] = true
+	}
+	return teams
+}
@@ -9,7 +9,7 @@ import (
 
 // mainViewCheckMsg is sent after the check delay completes.
 type mainViewCheckMsg struct {
-	selection int // 0 for Stats, 1 for Live Matches
+	selection int // 0 for Stats, 1 for Live Matches, 2 for Schedule, 3 for Leaders
 }
 
 // performMainViewCheck performs a delay check before navigating.
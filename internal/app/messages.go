@@ -2,10 +2,17 @@ package app
 
 import (
 	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/highlights"
 	"github.com/gabriel7419/courtside/internal/nba"
 	"github.com/gabriel7419/courtside/internal/reddit"
 )
 
+// debugLogMsg carries a line for the debug log from a command's goroutine,
+// so that it is written from Update like every other log line.
+type debugLogMsg struct {
+	message string
+}
+
 // liveUpdateMsg contains a live update string for match events.
 type liveUpdateMsg struct {
 	update string
@@ -49,6 +56,55 @@ type statsDayDataMsg struct {
 	upcoming []api.Match // upcoming matches (only for today)
 }
 
+// scheduleDayMsg contains all games for one day of the schedule view.
+// Sent when a lazily requested day finishes loading.
+type scheduleDayMsg struct {
+	day     string      // "YYYY-MM-DD" key of the requested day
+	matches []api.Match // every game on that day, any status
+	err     error
+}
+
+// teamProfileMsg contains a team's season page.
+// Used to open the team dialog.
+type teamProfileMsg struct {
+	teamID  int
+	profile *api.TeamProfile
+	err     error
+}
+
+// playerProfileMsg contains a player's profile.
+// Used to open the player dialog; matchID is the game it was opened from.
+type playerProfileMsg struct {
+	playerID int
+	matchID  int
+	profile  *api.PlayerProfile
+	err      error
+}
+
+// leadersMsg contains a league leaders table.
+// query identifies the table so stale responses can be ignored.
+type leadersMsg struct {
+	query   nba.LeadersQuery
+	leaders []api.LeagueLeader
+	err     error
+}
+
+// gamePreviewMsg contains the pre-game preview of a scheduled game.
+type gamePreviewMsg struct {
+	matchID int
+	preview *api.GamePreview
+	err     error
+}
+
+// tipOffMsg is sent when a selected scheduled game reaches its tip-off time,
+// so the schedule can check whether it has started.
+type tipOffMsg struct {
+	matchID int
+}
+
+// reminderTickMsg is sent every ReminderCheckInterval to fire due tip-off reminders.
+type reminderTickMsg struct{}
+
 // pollTickMsg is sent when the 90-second poll interval elapses.
 // This triggers the actual API call with loading state visible.
 type pollTickMsg struct {
@@ -59,13 +115,30 @@ type pollTickMsg struct {
 // This allows the "Updating..." spinner to be visible for at least 1 second.
 type pollDisplayCompleteMsg struct{}
 
-// goalLinksMsg contains goal replay links fetched from Reddit.
-// Sent after searching r/soccer for Media posts matching goal events.
-type goalLinksMsg struct {
+// highlightsMsg contains the clips of a game's notable plays, best first.
+// Sent after asking the highlight providers for clips of the plays.
+type highlightsMsg struct {
 	matchID int
-	links   map[reddit.GoalLinkKey]*reddit.GoalLink
+	clips   map[reddit.PlayKey][]highlights.Clip
+	err     error // of the providers that failed
 }
 
+// gameThreadsMsg contains a game's r/nba game and post-game thread links.
+type gameThreadsMsg struct {
+	matchID int
+	threads *reddit.GameThreads
+}
+
+// commentsMsg contains the newest comments of a game's r/nba game thread.
+type commentsMsg struct {
+	matchID  int
+	comments []reddit.Comment
+	err      error
+}
+
+// commentsTickMsg is sent every refresh.comments interval while the comments panel is open.
+type commentsTickMsg struct{}
+
 // standingsMsg contains league standings from API response.
 // Used to populate the standings dialog.
 type standingsMsg struct {
@@ -2,19 +2,21 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/gabriel7419/courtside/internal/api"
 	"github.com/gabriel7419/courtside/internal/constants"
 	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/highlights"
 	"github.com/gabriel7419/courtside/internal/nba"
 	"github.com/gabriel7419/courtside/internal/notify"
 	"github.com/gabriel7419/courtside/internal/reddit"
@@ -30,6 +32,8 @@ const (
 	viewLiveMatches
 	viewStats
 	viewSettings
+	viewSchedule
+	viewLeaders
 )
 
 // model holds the application state.
@@ -51,21 +55,41 @@ type model struct {
 	matchDetailsCache   map[int]*api.MatchDetails // Cache to avoid repeated API calls
 	liveUpdates         []string
 	lastEvents          []api.MatchEvent
-	lastHomeScore       int // Track last known home score for goal notifications
-	lastAwayScore       int // Track last known away score for goal notifications
 
-	// Stats data cache - stores 5 days of data, filtered client-side for Today/3d/5d views
+	// Stats data cache - stores refresh.stats_days days of data (5 by default),
+	// filtered client-side for the Today/3d/5d views
 	statsData *nba.StatsData
 
 	// Progressive loading state (stats view)
 	statsDaysLoaded int // Number of days loaded so far (0-5)
-	statsTotalDays  int // Total days to load (5)
+	statsTotalDays  int // Total days to load (refresh.stats_days)
 
 	// Progressive loading state (live view) - batch-based for parallel fetching
 	liveBatchesLoaded int         // Number of batches loaded so far
 	liveTotalBatches  int         // Total batches to load
 	liveMatchesBuffer []api.Match // Buffer to accumulate live matches during progressive load
 
+	// Schedule view state - games are fetched lazily per day and kept for the session
+	scheduleDate            time.Time              // Day currently shown (local noon)
+	scheduleDays            map[string][]api.Match // Loaded days keyed by "YYYY-MM-DD"
+	scheduleLoading         bool                   // Current day is being fetched
+	scheduleDateInput       textinput.Model        // Jump-to-date input
+	scheduleDateInputActive bool                   // Whether the jump-to-date input has focus
+	scheduleDateInputHint   string                 // Validation message for the date input
+	schedulePendingMatchID  int                    // Game to select once its day loads (0 = first game)
+	schedulePreview         *api.GamePreview       // Pre-game preview of the selected scheduled game
+	scheduleTipOffMatchID   int                    // Scheduled game with a pending tip-off check (0 = none)
+
+	// Leaders view state - tables are cached by the client, today's games come from scheduleDays
+	leadersCategory int                // Index into nba.LeaderCategories
+	leadersMode     string             // nba.LeadersPerGame, nba.LeadersTotals or nba.LeadersPer36
+	leadersSeason   string             // Season shown, e.g. "2025-26"
+	leadersPlayoffs bool               // Playoffs instead of regular season
+	leaders         []api.LeagueLeader // Table for the current query
+	leadersCursor   int                // Selected row
+	leadersLoading  bool               // Current table is being fetched
+	leadersFailed   bool               // Last fetch for the current query failed
+
 	// UI components
 	spinner          spinner.Model
 	randomSpinner    *ui.RandomCharSpinner
@@ -76,6 +100,7 @@ type model struct {
 	liveMatchesList        list.Model
 	statsMatchesList       list.Model
 	upcomingMatchesList    list.Model
+	scheduleMatchesList    list.Model
 	statsDetailsViewport   viewport.Model // Scrollable viewport for match details in stats view
 	statsRightPanelFocused bool           // Whether right panel is focused for scrolling
 	statsScrollOffset      int            // Manual scroll offset for right panel content
@@ -86,18 +111,22 @@ type model struct {
 	liveViewLoading  bool
 	statsViewLoading bool
 	polling          bool
-	pendingSelection int // Tracks which view is being preloaded (-1 = none, 0 = stats, 1 = live)
+	pendingSelection int // Tracks which view is being preloaded (-1 = none, 0 = stats, 1 = live, 2 = schedule, 3 = leaders)
 
 	// Configuration
 	useMockData         bool
 	debugMode           bool   // Enable debug logging to file
 	isDevBuild          bool   // Whether this is a development build
 	newVersionAvailable bool   // Whether a new version of Golazo is available
+	migrated            bool   // Whether this is the first run after the move out of the Golazo directories
 	appVersion          string // Current application version string
-	statsDateRange      int    // 1, 3, or 5 days (default: 1)
+	statsDateRange      int    // 1, 3 or refresh.stats_days days (default: 1)
 
-	// Settings view state
+	// Settings view state, and the effective settings: which games the lists
+	// show and how often data refreshes
 	settingsState *ui.SettingsState
+	settings      *data.Settings
+	settingsErr   error // Problems with settings.yaml or the environment found at startup
 
 	// Dialog overlay for modal dialogs
 	dialogOverlay *ui.DialogOverlay
@@ -105,13 +134,45 @@ type model struct {
 	// API clients
 	nbaClient    *nba.Client
 	parser       *nba.LiveUpdateParser
+	milestones   *nba.MilestoneWatcher // Milestones reached while watching the live game
 	redditClient *reddit.Client
 
-	// Goal replay links from Reddit (keyed by matchID:minute)
-	goalLinks map[reddit.GoalLinkKey]*reddit.GoalLink
+	// Highlight clip sources from settings (r/nba by default)
+	highlightProviders *highlights.Providers
+
+	// Catch-up card for a game reopened after a while (see trackLastSeen)
+	catchUp         *nba.CatchUp
+	lastSeenMatchID int
+
+	// Highlight clips of notable plays, the games with a search running and
+	// each game's notable plays as last searched (for the highlights reel)
+	highlights        map[reddit.PlayKey][]highlights.Clip
+	highlightSearches map[int]bool
+	notablePlays      map[int][]nba.NotablePlay
+
+	// r/nba game and post-game threads by match ID, and the games with a search running
+	gameThreads    map[int]*reddit.GameThreads
+	threadSearches map[int]bool
+
+	// Game thread comments panel of the live view, with the game the
+	// comments are of and whether the poll is running (see toggleComments)
+	showComments    bool
+	comments        []reddit.Comment
+	commentsMatchID int
+	commentsErr     error
+	commentsPolling bool
 
 	// Notifications
-	notifier *notify.DesktopNotifier
+	notifier         *notify.DesktopNotifier
+	notifyDispatcher *notify.Dispatcher // Applies notification rules to each poll of the live game
+	notifyHistory    *notify.History    // Fired notifications, shown in the inbox dialog
+	reminders        *notify.Reminders  // Tip-off reminders of scheduled games
+	reminderMinutes  int                // Default reminder lead time from settings
+	reminderLeads    map[int]int        // Lead time by match ID of each reminder, refreshed from disk
+
+	// Upcoming games under the live list; tab moves focus there to set reminders
+	liveUpcomingFocused bool
+	liveUpcomingCursor  int
 
 	// Logo animation (main view only)
 	animatedLogo *logo.AnimatedLogo
@@ -122,8 +183,9 @@ type model struct {
 // debugMode enables debug logging to a file.
 // isDevBuild indicates if this is a development build.
 // newVersionAvailable indicates if a newer version is available.
+// migrated indicates the first run after the move out of the Golazo directories.
 // appVersion is the current application version string.
-func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable bool, appVersion string) model {
+func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable bool, migrated bool, appVersion string) model {
 	s := spinner.New()
 	s.Spinner = spinner.Line
 	s.Style = ui.SpinnerStyle()
@@ -153,6 +215,16 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	liveList.Styles.FilterCursor = filterCursorStyle
 	liveList.FilterInput.PromptStyle = filterPromptStyle
 	liveList.FilterInput.Cursor.Style = filterCursorStyle
+	liveList.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			key.NewBinding(key.WithKeys("m"), key.WithHelp("m", "mute game")),
+			key.NewBinding(key.WithKeys("z"), key.WithHelp("z", "snooze 1h")),
+			key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "open thread")),
+			key.NewBinding(key.WithKeys("C"), key.WithHelp("C", "comments")),
+			key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "clips")),
+			key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "upcoming: a remind")),
+		}
+	}
 
 	statsList := list.New([]list.Item{}, delegate, 0, 0)
 	statsList.SetShowTitle(false)
@@ -183,16 +255,37 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	upcomingList.FilterInput.PromptStyle = filterPromptStyle
 	upcomingList.FilterInput.Cursor.Style = filterCursorStyle
 
+	scheduleList := list.New([]list.Item{}, delegate, 0, 0)
+	scheduleList.SetShowTitle(false)
+	scheduleList.SetShowStatusBar(true)
+	scheduleList.SetFilteringEnabled(true)
+	scheduleList.SetShowFilter(true)
+	scheduleList.Filter = list.DefaultFilter // Required for filtering to work
+	scheduleList.Styles.FilterCursor = filterCursorStyle
+	scheduleList.FilterInput.PromptStyle = filterPromptStyle
+	scheduleList.FilterInput.Cursor.Style = filterCursorStyle
+
+	// Jump-to-date input for the schedule view
+	dateInput := textinput.New()
+	dateInput.Prompt = "Go to: "
+	dateInput.Placeholder = "YYYY-MM-DD"
+	dateInput.CharLimit = 10
+	dateInput.PromptStyle = filterPromptStyle
+	dateInput.Cursor.Style = filterCursorStyle
+
+	// Notification rules and tunables come from settings and the environment;
+	// a missing file means defaults. Problems are shown in a banner, and
+	// what is valid is used anyway.
+	settings, settingsErr := effectiveSettings()
+
 	// Initialize Reddit client (best-effort, nil if fails)
 	var redditClient *reddit.Client
 	if debugMode {
-		redditClient, _ = reddit.NewClientWithDebug(func(message string) {
+		redditClient, _ = reddit.NewClientWithDebug(settings.Reddit, func(message string) {
 			// This will be called by the Reddit client for debug logging
 			// We'll create a model instance to access debugLog, but for now just log directly
 			// This is a bit of a hack, but it works for debug logging
-			configDir, _ := data.ConfigDir()
-			if configDir != "" {
-				logFile := filepath.Join(configDir, "golazo_debug.log")
+			if logFile, err := data.DebugLogPath(); err == nil {
 				f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 				if err == nil {
 					defer func() { _ = f.Close() }()
@@ -201,25 +294,60 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 			}
 		})
 	} else {
-		redditClient, _ = reddit.NewClient()
+		redditClient, _ = reddit.NewClient(settings.Reddit)
+	}
+
+	notifier := notify.NewDesktopNotifier()
+	sinks, err := notify.NewNotifier(notifier, settings)
+	settingsErr = errors.Join(settingsErr, err)
+	dispatcher := notify.NewDispatcher(sinks, settings)
+	highlightProviders, err := highlights.FromSettings(settings, redditClient)
+	settingsErr = errors.Join(settingsErr, err)
+	var history *notify.History
+	if path, err := notify.HistoryPath(); err == nil {
+		history = notify.NewHistory(path)
+		dispatcher.SetHistory(history)
+	}
+	var reminders *notify.Reminders
+	reminderLeads := make(map[int]int)
+	if path, err := notify.RemindersPath(); err == nil {
+		reminders = notify.NewReminders(path)
+		list, _ := reminders.List()
+		for _, rem := range list {
+			reminderLeads[rem.MatchID] = rem.LeadMinutes(settings.Notifications.ReminderMinutes)
+		}
 	}
 
 	// Initialize animated logo for main view
 	animatedLogo := logo.NewAnimatedLogoWithType(appVersion, false, logo.DefaultOpts(), 1200, 1, logo.AnimationWave)
 
-	return model{
+	m := model{
 		currentView:            viewMain,
 		matchDetailsCache:      make(map[int]*api.MatchDetails),
 		useMockData:            useMockData,
 		debugMode:              debugMode,
 		isDevBuild:             isDevBuild,
 		newVersionAvailable:    newVersionAvailable,
+		migrated:               migrated,
 		appVersion:             appVersion,
-		nbaClient:              selectNBAClient(useMockData),
+		nbaClient:              selectNBAClient(useMockData, nba.ConfigFromSettings(settings.NBA)),
 		parser:                 nba.NewLiveUpdateParser(),
+		milestones:             nba.NewMilestoneWatcher(),
 		redditClient:           redditClient,
-		goalLinks:              make(map[reddit.GoalLinkKey]*reddit.GoalLink),
-		notifier:               notify.NewDesktopNotifier(),
+		settings:               settings,
+		settingsErr:            settingsErr,
+		highlightProviders:     highlightProviders,
+		highlights:             make(map[reddit.PlayKey][]highlights.Clip),
+		highlightSearches:      make(map[int]bool),
+		notablePlays:           make(map[int][]nba.NotablePlay),
+		gameThreads:            make(map[int]*reddit.GameThreads),
+		threadSearches:         make(map[int]bool),
+		notifier:               notifier,
+		notifyDispatcher:       dispatcher,
+		notifyHistory:          history,
+		reminders:              reminders,
+		reminderMinutes:        settings.Notifications.ReminderMinutes,
+		reminderLeads:          reminderLeads,
 		spinner:                s,
 		randomSpinner:          randomSpinner,
 		statsViewSpinner:       statsViewSpinner,
@@ -227,22 +355,36 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		liveMatchesList:        liveList,
 		statsMatchesList:       statsList,
 		upcomingMatchesList:    upcomingList,
+		scheduleMatchesList:    scheduleList,
+		scheduleDays:           make(map[string][]api.Match),
+		scheduleDateInput:      dateInput,
 		statsDetailsViewport:   statsDetailsViewport,
 		statsRightPanelFocused: false, // Start with left panel focused
 		statsScrollOffset:      0,     // Start at top
 		statsDateRange:         1,
+		statsTotalDays:         settings.Refresh.StatsDays,
 		pendingSelection:       -1,                    // No pending selection
 		dialogOverlay:          ui.NewDialogOverlay(), // Initialize dialog overlay
 		animatedLogo:           animatedLogo,          // Initialize animated logo
 	}
+	if settingsErr != nil {
+		m.debugLog(fmt.Sprintf("settings: %v", settingsErr))
+	}
+	return m
 }
 
 // getStatusBannerType returns the appropriate status banner type based on current model state.
-// Priority: Debug > Dev > New Version > None
+// Priority: Debug > Migrated > Settings > Dev > New Version > None
 func (m model) getStatusBannerType() constants.StatusBannerType {
 	if m.debugMode {
 		return constants.StatusBannerDebug
 	}
+	if m.migrated {
+		return constants.StatusBannerMigrated
+	}
+	if m.settingsErr != nil {
+		return constants.StatusBannerSettings
+	}
 	if m.isDevBuild {
 		return constants.StatusBannerDev
 	}
@@ -315,20 +457,54 @@ func (m model) getHeaderContentHeight() int {
 	if m.matchDetails.Attendance > 0 {
 		height++
 	}
+	if m.threadLinks() != nil {
+		height++
+	}
 
 	return height
 }
 
 // Init initializes the application.
 func (m model) Init() tea.Cmd {
-	return tea.Batch(m.spinner.Tick, ui.SpinnerTick())
+	return tea.Batch(m.spinner.Tick, ui.SpinnerTick(), scheduleReminderCheck())
 }
 
 // selectNBAClient returns a MockClient for offline/development mode,
 // or a real Client when the NBA Stats API should be used.
-func selectNBAClient(useMock bool) *nba.Client {
+func selectNBAClient(useMock bool, cfg nba.Config) *nba.Client {
 	// NOTE: MockClient also satisfies api.Client, but nbaClient field is *nba.Client.
 	// We store useMockData on the model and check it in commands.go to switch data sources.
 	_ = useMock // see commands.go fetchStatsDayData / fetchLiveBatchData for mock handling
-	return nba.NewClient()
+	return nba.NewClientWithConfig(cfg)
+}
+
+// effectiveSettings loads settings.yaml with the environment's overrides and
+// the defaults of unset keys. The error reports what `courtside config
+// validate` would: a file that doesn't parse, which means defaults, invalid
+// values and environment overrides that were skipped.
+func effectiveSettings() (*data.Settings, error) {
+	settings, err := data.ReadSettings()
+	if err != nil {
+		settings = &data.Settings{}
+	} else {
+		err = settings.Validate()
+	}
+	effective, envErr := settings.Effective()
+	return effective, errors.Join(err, envErr)
+}
+
+// applySettings makes settings the effective settings, passing them on to
+// what keeps its own copy: the notification rules, the reminder lead time
+// and the highlight sources.
+func (m *model) applySettings(settings *data.Settings) {
+	m.settings = settings
+	m.notifyDispatcher.SetSettings(settings)
+	m.reminderMinutes = settings.Notifications.ReminderMinutes
+	m.refreshReminders()
+
+	providers, err := highlights.FromSettings(settings, m.redditClient)
+	if err != nil {
+		m.debugLog(fmt.Sprintf("highlight sources: %v", err))
+	}
+	m.highlightProviders = providers
 }
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// openBoxScoreDialog opens the full box score for the current game.
+func (m *model) openBoxScoreDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+	if len(m.matchDetails.HomePlayerStats) == 0 && len(m.matchDetails.AwayPlayerStats) == 0 {
+		return
+	}
+	m.dialogOverlay.OpenDialog(ui.NewBoxScoreDialog(m.matchDetails))
+}
+
+// openPlayer requests a player's profile; the dialog opens when it arrives.
+// The current game (or the leaders season type) decides whether the playoff game log
+// is fetched; the current game's row is highlighted.
+func (m model) openPlayer(playerID int, name string) (tea.Model, tea.Cmd) {
+	if playerID == 0 {
+		return m, nil
+	}
+	matchID, playoffs := 0, false
+	if m.matchDetails != nil {
+		matchID, playoffs = m.matchDetails.ID, m.matchDetails.IsPlayoffs
+	}
+	if m.currentView == viewLeaders {
+		playoffs = m.leadersPlayoffs
+	}
+	return m, fetchPlayerProfile(m.nbaClient, m.useMockData, playerID, name, matchID, playoffs)
+}
+
+// handlePlayerProfile opens the player dialog with the fetched profile.
+func (m model) handlePlayerProfile(msg playerProfileMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil || msg.profile == nil {
+		m.debugLog(fmt.Sprintf("player: failed to load player %d: %v", msg.playerID, msg.err))
+		return m, nil
+	}
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	m.dialogOverlay.OpenDialog(ui.NewPlayerDialog(msg.profile, msg.matchID))
+	return m, nil
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/notify"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// handleReminderTick fires due reminders in the background and schedules the next check.
+func (m model) handleReminderTick() (tea.Model, tea.Cmd) {
+	m.refreshReminders()
+	return m, tea.Batch(
+		checkReminders(m.reminders, m.notifyDispatcher, m.reminderMinutes),
+		scheduleReminderCheck(),
+	)
+}
+
+// refreshReminders reloads the lead time of every game with a reminder, which
+// the views read on each render.
+func (m *model) refreshReminders() {
+	m.reminderLeads = make(map[int]int)
+	if m.reminders == nil {
+		return
+	}
+	list, err := m.reminders.List()
+	if err != nil {
+		m.debugLog(fmt.Sprintf("reminders: %v", err))
+		return
+	}
+	for _, rem := range list {
+		m.reminderLeads[rem.MatchID] = rem.LeadMinutes(m.reminderMinutes)
+	}
+}
+
+// toggleReminder sets or removes the tip-off reminder of a scheduled game
+// and returns the status message to show.
+func (m *model) toggleReminder(match api.Match) string {
+	if m.reminders == nil || match.Status != api.MatchStatusNotStarted {
+		return ""
+	}
+	on, err := m.reminders.Toggle(match)
+	if err != nil {
+		m.debugLog(fmt.Sprintf("reminders: %v", err))
+		return ""
+	}
+	m.refreshReminders()
+	if !on {
+		return fmt.Sprintf(constants.StatusReminderRemoved, matchupLabel(match))
+	}
+	return fmt.Sprintf(constants.StatusReminderSet, matchupLabel(match), m.reminderLeads[match.ID])
+}
+
+// cycleReminderLead moves a game's reminder to the next of notify.ReminderLeadTimes.
+func (m *model) cycleReminderLead(match api.Match) string {
+	if m.reminders == nil {
+		return ""
+	}
+	rem, ok := m.reminders.Get(match.ID)
+	if !ok {
+		return ""
+	}
+	leads := notify.ReminderLeadTimes
+	i := slices.Index(leads, rem.LeadMinutes(m.reminderMinutes))
+	rem.Minutes = leads[(i+1)%len(leads)]
+	if err := m.reminders.Set(rem); err != nil {
+		m.debugLog(fmt.Sprintf("reminders: %v", err))
+		return ""
+	}
+	m.refreshReminders()
+	return fmt.Sprintf(constants.StatusReminderSet, matchupLabel(match), rem.Minutes)
+}
+
+// reminderKey handles a reminder key for a game: a sets or removes its
+// reminder, A changes the lead time. It returns the status to show.
+func (m *model) reminderKey(match api.Match, key string) string {
+	if key == "A" {
+		return m.cycleReminderLead(match)
+	}
+	return m.toggleReminder(match)
+}
+
+// handleLiveUpcomingKeys handles the upcoming games under the live list while
+// they have focus: j/k select, a toggles a reminder, A changes its lead time
+// and tab gives focus back to the live list.
+func (m model) handleLiveUpcomingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	upcoming := m.liveUpcomingMatches
+	switch msg.String() {
+	case "tab":
+		m.liveUpcomingFocused = false
+	case "j", "down":
+		if m.liveUpcomingCursor < len(upcoming)-1 {
+			m.liveUpcomingCursor++
+		}
+	case "k", "up":
+		if m.liveUpcomingCursor > 0 {
+			m.liveUpcomingCursor--
+		}
+	case "a", "A":
+		if m.liveUpcomingCursor >= len(upcoming) {
+			return m, nil
+		}
+		if status := m.reminderKey(upcoming[m.liveUpcomingCursor].Match, msg.String()); status != "" {
+			return m, m.liveMatchesList.NewStatusMessage(status)
+		}
+	}
+	return m, nil
+}
+
+// liveUpcomingDisplay returns the upcoming games of the live view with the
+// cursor and reminders marked.
+func (m model) liveUpcomingDisplay() []ui.MatchDisplay {
+	if len(m.liveUpcomingMatches) == 0 {
+		return nil
+	}
+	display := make([]ui.MatchDisplay, len(m.liveUpcomingMatches))
+	for i, match := range m.liveUpcomingMatches {
+		match.Selected = m.liveUpcomingFocused && i == m.liveUpcomingCursor
+		match.Reminder = m.reminderLeads[match.ID] > 0
+		display[i] = match
+	}
+	return display
+}
+
+// scheduleReminder returns the lead time of the selected schedule game's
+// reminder, or 0 when it has none.
+func (m model) scheduleReminder() int {
+	if match := m.selectedScheduleMatch(); match != nil {
+		return m.reminderLeads[match.ID]
+	}
+	return 0
+}
+
+// matchupLabel renders "MIA @ BOS".
+func matchupLabel(match api.Match) string {
+	return match.AwayTeam.ShortName + " @ " + match.HomeTeam.ShortName
+}
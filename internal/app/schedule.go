@@ -0,0 +1,347 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// scheduleDayKey is the layout used to key schedule days.
+const scheduleDayKey = "2006-01-02"
+
+// scheduleDay normalizes t to noon of its local calendar day.
+// Noon keeps the UTC date used by MatchesByDate on the same day for every
+// timezone within ±12h, so the games shown match the day the user picked.
+func scheduleDay(t time.Time) time.Time {
+	y, mo, d := t.Local().Date()
+	return time.Date(y, mo, d, 12, 0, 0, 0, time.Local)
+}
+
+// parseScheduleDate parses jump-to-date input relative to now.
+// Accepts "YYYY-MM-DD", "MM-DD" or "MM/DD" (current year), "+N"/"-N" days,
+// and the words today, tomorrow and yesterday.
+func parseScheduleDate(input string, now time.Time) (time.Time, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	today := scheduleDay(now)
+
+	switch input {
+	case "", "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if input[0] == '+' || input[0] == '-' {
+		n, err := strconv.Atoi(input)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid day offset %q", input)
+		}
+		return today.AddDate(0, 0, n), nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", input, time.Local); err == nil {
+		return scheduleDay(t), nil
+	}
+
+	normalized := strings.ReplaceAll(input, "/", "-")
+	if t, err := time.ParseInLocation("1-2", normalized, time.Local); err == nil {
+		return time.Date(today.Year(), t.Month(), t.Day(), 12, 0, 0, 0, time.Local), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q", input)
+}
+
+// handleScheduleKeys processes keyboard input for the schedule view.
+// h/l move one day, t jumps to today and g opens the jump-to-date input.
+func (m model) handleScheduleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.scheduleDateInputActive {
+		return m.handleScheduleDateInput(msg)
+	}
+
+	isFiltering := m.scheduleMatchesList.FilterState() == list.Filtering
+
+	// Right panel focused - scroll details and open dialogs (t/T: home/away team, b: box score)
+	if m.statsRightPanelFocused {
+		switch msg.String() {
+		case "up", "k":
+			if m.statsScrollOffset > 0 {
+				m.statsScrollOffset--
+			}
+			return m, nil
+		case "down", "j":
+			m.statsScrollOffset++
+			return m, nil
+		case "tab":
+			m.statsRightPanelFocused = false
+			return m, nil
+		case "s":
+			if m.matchDetails != nil {
+				return m, fetchStandings(m.nbaClient, 0, m.matchDetails.League.Name, 0, m.matchDetails.HomeTeam.ID, m.matchDetails.AwayTeam.ID)
+			}
+			return m, nil
+		case "x":
+			m.openStatisticsDialog()
+			return m, nil
+		case "t", "T", "b", "v":
+			updated, cmd, _ := m.handleGameDialogKeys(msg)
+			return updated, cmd
+		}
+	}
+
+	if !isFiltering {
+		switch msg.String() {
+		case "h", "left":
+			return m.loadScheduleDay(m.scheduleDate.AddDate(0, 0, -1))
+		case "l", "right":
+			return m.loadScheduleDay(m.scheduleDate.AddDate(0, 0, 1))
+		case "t":
+			return m.loadScheduleDay(time.Now())
+		case "g":
+			m.scheduleDateInputActive = true
+			m.scheduleDateInputHint = ""
+			m.scheduleDateInput.SetValue("")
+			return m, m.scheduleDateInput.Focus()
+		case "a", "A":
+			// a: set or remove a tip-off reminder, A: change its lead time
+			if match := m.selectedScheduleMatch(); match != nil {
+				if status := m.reminderKey(*match, msg.String()); status != "" {
+					return m, m.scheduleMatchesList.NewStatusMessage(status)
+				}
+			}
+			return m, nil
+		case "tab":
+			m.statsRightPanelFocused = !m.statsRightPanelFocused
+			m.statsScrollOffset = 0
+			return m, nil
+		case "r":
+			// Drop the cached day so the scoreboard is fetched again
+			delete(m.scheduleDays, m.scheduleDate.Format(scheduleDayKey))
+			if m.matchDetails != nil {
+				delete(m.matchDetailsCache, m.matchDetails.ID)
+			}
+			return m.loadScheduleDay(m.scheduleDate)
+		}
+	}
+
+	var preUpdateMatchID int
+	if item, ok := m.scheduleMatchesList.SelectedItem().(ui.MatchListItem); ok {
+		preUpdateMatchID = item.Match.ID
+	}
+
+	var listCmd tea.Cmd
+	m.scheduleMatchesList, listCmd = m.scheduleMatchesList.Update(msg)
+
+	item, ok := m.scheduleMatchesList.SelectedItem().(ui.MatchListItem)
+	if !ok {
+		return m, listCmd
+	}
+
+	// Use pre-update selection on enter (filter clears and moves the cursor)
+	target := item.Match
+	if msg.String() == "enter" && preUpdateMatchID != 0 && preUpdateMatchID != target.ID {
+		for _, li := range m.scheduleMatchesList.Items() {
+			if mi, ok := li.(ui.MatchListItem); ok && mi.Match.ID == preUpdateMatchID {
+				target = mi.Match
+				break
+			}
+		}
+	}
+
+	if target.ID != preUpdateMatchID || msg.String() == "enter" {
+		updated, cmd := m.selectScheduleMatch(target)
+		return updated, tea.Batch(listCmd, cmd)
+	}
+
+	return m, listCmd
+}
+
+// handleScheduleDateInput handles keys while the jump-to-date input is focused.
+func (m model) handleScheduleDateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.scheduleDateInputActive = false
+		m.scheduleDateInputHint = ""
+		m.scheduleDateInput.Blur()
+		return m, nil
+	case "enter":
+		day, err := parseScheduleDate(m.scheduleDateInput.Value(), time.Now())
+		if err != nil {
+			m.scheduleDateInputHint = err.Error()
+			return m, nil
+		}
+		m.scheduleDateInputActive = false
+		m.scheduleDateInputHint = ""
+		m.scheduleDateInput.Blur()
+		return m.loadScheduleDay(day)
+	}
+
+	var cmd tea.Cmd
+	m.scheduleDateInput, cmd = m.scheduleDateInput.Update(msg)
+	return m, cmd
+}
+
+// loadScheduleDay switches the schedule view to the given day.
+// Days already fetched this session are shown instantly; others are fetched lazily.
+func (m model) loadScheduleDay(day time.Time) (tea.Model, tea.Cmd) {
+	m.scheduleDate = scheduleDay(day)
+	m.matchDetails = nil
+	m.statsRightPanelFocused = false
+	m.statsScrollOffset = 0
+	m.scheduleMatchesList.ResetFilter()
+
+	if matches, ok := m.scheduleDays[m.scheduleDate.Format(scheduleDayKey)]; ok {
+		m.scheduleLoading = false
+		return m.applyScheduleDay(matches)
+	}
+
+	m.scheduleMatchesList.SetItems([]list.Item{})
+	m.scheduleLoading = true
+	m.loading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchScheduleDay(m.nbaClient, m.useMockData, m.scheduleDate))
+}
+
+// handleScheduleDay stores a fetched day and shows it if it is still the current day.
+func (m model) handleScheduleDay(msg scheduleDayMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("schedule: failed to load %s: %v", msg.day, msg.err))
+	} else {
+		m.scheduleDays[msg.day] = msg.matches
+	}
+
+	// Ignore days the user already navigated away from, and today's games
+	// fetched for other views (e.g. leaders highlighting)
+	if msg.day != m.scheduleDate.Format(scheduleDayKey) ||
+		(m.currentView != viewSchedule && m.pendingSelection != 2) {
+		return m, nil
+	}
+
+	m.scheduleLoading = false
+	m.loading = false
+	return m.applyScheduleDay(msg.matches)
+}
+
+// applyScheduleDay fills the schedule list in tip-off order and selects the
+// pending game, or the first game of the day.
+func (m model) applyScheduleDay(matches []api.Match) (tea.Model, tea.Cmd) {
+	sorted := make([]api.Match, 0, len(matches))
+	for _, match := range matches {
+		// A game opened from elsewhere is listed whatever the conference filter
+		if m.settings.ShowsMatch(match) || match.ID == m.schedulePendingMatchID {
+			sorted = append(sorted, match)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].MatchTime == nil || sorted[j].MatchTime == nil {
+			return sorted[j].MatchTime == nil && sorted[i].MatchTime != nil
+		}
+		return sorted[i].MatchTime.Before(*sorted[j].MatchTime)
+	})
+
+	displayMatches := make([]ui.MatchDisplay, 0, len(sorted))
+	for _, match := range sorted {
+		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match, Favorite: m.settings.IsFavoriteMatch(match)})
+	}
+	m.scheduleMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+
+	if len(sorted) == 0 {
+		m.matchDetails = nil
+		m.schedulePendingMatchID = 0
+		return m, nil
+	}
+
+	// A game opened from elsewhere (e.g. the team page) takes precedence
+	index := 0
+	for i, match := range sorted {
+		if match.ID == m.schedulePendingMatchID {
+			index = i
+			break
+		}
+	}
+	m.schedulePendingMatchID = 0
+
+	m.scheduleMatchesList.Select(index)
+	return m.selectScheduleMatch(sorted[index])
+}
+
+// selectScheduleMatch shows the given game in the details panel.
+// Scheduled games show the tip-off card and load their preview; games tipping
+// off within a day also get a tip-off check so the panel switches to the live
+// layout by itself. Started and finished games load their details.
+func (m model) selectScheduleMatch(match api.Match) (tea.Model, tea.Cmd) {
+	m.statsScrollOffset = 0
+
+	if match.Status == api.MatchStatusNotStarted {
+		m.matchDetails = nil
+		var cmds []tea.Cmd
+		if m.schedulePreview == nil || m.schedulePreview.MatchID != match.ID {
+			m.schedulePreview = nil
+			cmds = append(cmds, fetchGamePreview(m.nbaClient, m.useMockData, match))
+		}
+		if match.MatchTime != nil && time.Until(*match.MatchTime) < 24*time.Hour && m.scheduleTipOffMatchID != match.ID {
+			m.scheduleTipOffMatchID = match.ID
+			cmds = append(cmds, scheduleTipOffCheck(match))
+		}
+		return m, tea.Batch(cmds...)
+	}
+
+	if cached, ok := m.matchDetailsCache[match.ID]; ok && match.Status == api.MatchStatusFinished {
+		m.matchDetails = cached
+		return m, nil
+	}
+
+	m.matchDetails = nil
+	m.loading = true
+	m.statsViewLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchScheduleMatchDetails(m.nbaClient, match, m.useMockData))
+}
+
+// handleGamePreview shows a fetched preview if its game is still selected.
+func (m model) handleGamePreview(msg gamePreviewMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil || msg.preview == nil {
+		m.debugLog(fmt.Sprintf("schedule: failed to load preview for %d: %v", msg.matchID, msg.err))
+		return m, nil
+	}
+	if selected := m.selectedScheduleMatch(); selected == nil || selected.ID != msg.matchID {
+		return m, nil
+	}
+	m.schedulePreview = msg.preview
+	return m, nil
+}
+
+// handleTipOff refetches the day of a selected game that has reached tip-off.
+// The refreshed scoreboard reselects the game: once started it loads its
+// details, otherwise another check is scheduled.
+func (m model) handleTipOff(msg tipOffMsg) (tea.Model, tea.Cmd) {
+	if msg.matchID != m.scheduleTipOffMatchID {
+		return m, nil
+	}
+	m.scheduleTipOffMatchID = 0
+
+	selected := m.selectedScheduleMatch()
+	if m.currentView != viewSchedule || selected == nil || selected.ID != msg.matchID {
+		return m, nil
+	}
+
+	// Refetch in the background so the list stays visible meanwhile
+	delete(m.scheduleDays, m.scheduleDate.Format(scheduleDayKey))
+	m.schedulePendingMatchID = msg.matchID
+	return m, fetchScheduleDay(m.nbaClient, m.useMockData, m.scheduleDate)
+}
+
+// selectedScheduleMatch returns the game under the cursor in the schedule list.
+func (m model) selectedScheduleMatch() *api.Match {
+	if item, ok := m.scheduleMatchesList.SelectedItem().(ui.MatchListItem); ok {
+		match := item.Match
+		return &match
+	}
+	return nil
+}
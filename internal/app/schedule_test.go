@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleDate(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 22, 30, 0, 0, time.Local)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 12, 0, 0, 0, time.Local)
+	}
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{input: "", want: day(time.March, 10)},
+		{input: " Today ", want: day(time.March, 10)},
+		{input: "tomorrow", want: day(time.March, 11)},
+		{input: "yesterday", want: day(time.March, 9)},
+		{input: "+3", want: day(time.March, 13)},
+		{input: "-10", want: day(time.February, 28)},
+		{input: "2025-12-25", want: time.Date(2025, time.December, 25, 12, 0, 0, 0, time.Local)},
+		{input: "04-01", want: day(time.April, 1)},
+		{input: "4/1", want: day(time.April, 1)},
+		{input: "+x", wantErr: true},
+		{input: "next friday", wantErr: true},
+		{input: "2026-13-01", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseScheduleDate(tt.input, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseScheduleDate(%q) = %v, want an error", tt.input, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseScheduleDate(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+	}
+}
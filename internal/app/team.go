@@ -0,0 +1,48 @@
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/ui"
+)
+
+// openTeam requests the team page for teamID; the dialog opens when it arrives.
+func (m model) openTeam(teamID int) (tea.Model, tea.Cmd) {
+	if teamID == 0 {
+		return m, nil
+	}
+	return m, fetchTeamProfile(m.nbaClient, m.useMockData, teamID)
+}
+
+// handleTeamProfile opens the team dialog with the fetched team page.
+func (m model) handleTeamProfile(msg teamProfileMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil || msg.profile == nil {
+		m.debugLog(fmt.Sprintf("team: failed to load team %d: %v", msg.teamID, msg.err))
+		return m, nil
+	}
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	m.dialogOverlay.OpenDialog(ui.NewTeamDialog(msg.profile))
+	return m, nil
+}
+
+// openMatchInSchedule switches to the schedule view on the game's day and selects it.
+// Leaving the live view stops its polling.
+func (m model) openMatchInSchedule(match api.Match) (tea.Model, tea.Cmd) {
+	if match.MatchTime == nil {
+		return m, nil
+	}
+
+	m.currentView = viewSchedule
+	m.polling = false
+	m.liveUpdates = nil
+	m.schedulePendingMatchID = match.ID
+	m.scheduleDateInputActive = false
+	m.scheduleDateInput.Blur()
+	m.ensureScheduleListSize()
+
+	return m.loadScheduleDay(*match.MatchTime)
+}
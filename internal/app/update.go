@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -11,8 +13,11 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
 	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/highlights"
 	"github.com/gabriel7419/courtside/internal/nba"
+	"github.com/gabriel7419/courtside/internal/notify"
 	"github.com/gabriel7419/courtside/internal/reddit"
 	"github.com/gabriel7419/courtside/internal/ui"
 )
@@ -52,6 +57,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case statsDayDataMsg:
 		return m.handleStatsDayData(msg)
 
+	case scheduleDayMsg:
+		return m.handleScheduleDay(msg)
+
+	case gamePreviewMsg:
+		return m.handleGamePreview(msg)
+
+	case tipOffMsg:
+		return m.handleTipOff(msg)
+
+	case reminderTickMsg:
+		return m.handleReminderTick()
+
+	case debugLogMsg:
+		m.debugLog(msg.message)
+		return m, nil
+
 	case ui.TickMsg:
 		return m.handleAnimationTick(msg)
 
@@ -68,12 +89,30 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Route filter matches message to the appropriate list based on current view
 		return m.handleFilterMatches(msg)
 
-	case goalLinksMsg:
-		return m.handleGoalLinks(msg)
+	case highlightsMsg:
+		return m.handleHighlights(msg)
+
+	case gameThreadsMsg:
+		return m.handleGameThreads(msg)
+
+	case commentsMsg:
+		return m.handleComments(msg)
+
+	case commentsTickMsg:
+		return m.handleCommentsTick()
 
 	case standingsMsg:
 		return m.handleStandings(msg)
 
+	case teamProfileMsg:
+		return m.handleTeamProfile(msg)
+
+	case playerProfileMsg:
+		return m.handlePlayerProfile(msg)
+
+	case leadersMsg:
+		return m.handleLeaders(msg)
+
 	default:
 		// Fallback handler for ui.TickMsg type assertion
 		if _, ok := msg.(ui.TickMsg); ok {
@@ -114,6 +153,9 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 			m.statsMatchesList.SetSize(availableWidth, availableHeight)
 		}
 
+	case viewSchedule:
+		m.ensureScheduleListSize()
+
 	case viewSettings:
 		// Settings list size is handled in RenderSettingsView
 		// but we update it here too for consistency
@@ -147,7 +189,7 @@ func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
 
 	// Continue polling if match is live
 	if m.polling && m.matchDetails != nil && m.matchDetails.Status == api.MatchStatusLive {
-		return m, schedulePollTick(m.matchDetails.ID)
+		return m, schedulePollTick(m.matchDetails.ID, m.settings.Refresh.Game)
 	}
 
 	m.loading = false
@@ -188,42 +230,16 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 			msg.details.ID, msg.details.HomeTeam.Name, msg.details.AwayTeam.Name))
 	}
 
-	// Load any cached goal links for this match into the model
-	// Filter out "__NOT_FOUND__" entries - only load valid replay URLs
-	if m.redditClient != nil {
-		cachedGoals := m.redditClient.Cache().All(msg.details.ID)
-		if len(cachedGoals) > 0 {
-			// Add cached goals to the model's goal links map
-			if m.goalLinks == nil {
-				m.goalLinks = make(map[reddit.GoalLinkKey]*reddit.GoalLink)
-			}
-			for _, goal := range cachedGoals {
-				// Only add goals with valid replay URLs (filter out "__NOT_FOUND__")
-				if ui.IsValidReplayURL(goal.URL) {
-					key := reddit.GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
-					m.goalLinks[key] = &goal
-				}
-			}
-		}
-	}
-
-	// Check if match has goals and fetch links immediately (main branch approach)
-	hasGoals := false
-	for _, event := range msg.details.Events {
-		if event.Type == "goal" {
-			hasGoals = true
-			break
-		}
-	}
-	if hasGoals {
-		cmds = append(cmds, fetchGoalLinks(m.redditClient, msg.details))
-	}
+	// Load the clips the providers already found for this match into the model
+	m.storeHighlights(m.highlightProviders.Cached(msg.details.ID))
 
-	// Cache for stats view (including during preload)
-	if m.currentView == viewStats || m.pendingSelection == 0 {
+	// Cache for stats and schedule views (including during preload)
+	if m.currentView == viewStats || m.pendingSelection == 0 ||
+		m.currentView == viewSchedule || m.pendingSelection == 2 {
 		m.matchDetailsCache[msg.details.ID] = msg.details
 		m.loading = false
 		m.statsViewLoading = false
+		cmds = append(cmds, m.searchHighlights(msg.details, msg.details.Events), m.searchGameThreads(msg.details))
 		return m, tea.Batch(cmds...)
 	}
 
@@ -231,31 +247,17 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	if m.currentView == viewLiveMatches || m.pendingSelection == 1 {
 		m.liveViewLoading = false
 
-		// Get current scores
-		homeScore := 0
-		awayScore := 0
-		if msg.details.HomeScore != nil {
-			homeScore = *msg.details.HomeScore
-		}
-		if msg.details.AwayScore != nil {
-			awayScore = *msg.details.AwayScore
-		}
-
-		// Detect new goals during poll refresh (not initial load)
-		// Only notify when: polling is active AND we have previous score data
-		hasScoreData := m.lastHomeScore > 0 || m.lastAwayScore > 0 || len(m.lastEvents) > 0
-		if m.polling && hasScoreData {
-			m.notifyNewGoals(msg.details)
-		}
-
-		// Update tracked scores for next comparison
-		m.lastHomeScore = homeScore
-		m.lastAwayScore = awayScore
+		// Detect game events since the last poll; the initial load only sets the baseline
+		cmds = append(cmds, m.notifyGameEvents(msg.details))
 
 		// Parse ALL events to rebuild the live updates list
-		// This ensures proper ordering (descending by minute) and uniqueness
-		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
+		// This ensures proper ordering (descending by minute) and uniqueness;
+		// milestones reached since the previous poll are listed with the plays
+		m.trackLastSeen(msg.details)
+		events := slices.Concat(msg.details.Events, m.milestones.Update(msg.details))
+		m.liveUpdates = m.parser.ParseEvents(events, msg.details.HomeTeam, msg.details.AwayTeam)
 		m.lastEvents = msg.details.Events
+		cmds = append(cmds, m.searchHighlights(msg.details, events), m.searchGameThreads(msg.details))
 
 		// Continue polling if match is live
 		if msg.details.Status == api.MatchStatusLive {
@@ -268,7 +270,7 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 
 			m.polling = true
 			// Schedule next poll tick (90 seconds from now)
-			cmds = append(cmds, schedulePollTick(msg.details.ID))
+			cmds = append(cmds, schedulePollTick(msg.details.ID, m.settings.Refresh.Game))
 		} else {
 			m.loading = false
 			m.polling = false
@@ -288,14 +290,15 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// If dialog overlay has active dialogs, route messages there first
 	if m.dialogOverlay != nil && m.dialogOverlay.HasDialogs() {
 		action := m.dialogOverlay.Update(msg)
-		if _, ok := action.(ui.DialogActionClose); ok {
-			m.dialogOverlay.CloseFrontDialog()
-		}
-		return m, nil
+		return m.handleDialogAction(action)
 	}
 
 	switch msg.String() {
 	case "q", "ctrl+c":
+		// Let "q" be typed into the jump-to-date input
+		if m.currentView == viewSchedule && m.scheduleDateInputActive && msg.String() == "q" {
+			break
+		}
 		return m, tea.Quit
 	case "esc":
 		// Check if any list is in filtering mode - if so, let the list handle Esc
@@ -308,6 +311,11 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case viewStats:
 			isFiltering = m.statsMatchesList.FilterState() == list.Filtering ||
 				m.statsMatchesList.FilterState() == list.FilterApplied
+		case viewSchedule:
+			// Esc also closes the jump-to-date input before leaving the view
+			isFiltering = m.scheduleDateInputActive ||
+				m.scheduleMatchesList.FilterState() == list.Filtering ||
+				m.scheduleMatchesList.FilterState() == list.FilterApplied
 		case viewSettings:
 			if m.settingsState != nil {
 				isFiltering = m.settingsState.List.FilterState() == list.Filtering ||
@@ -333,6 +341,10 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleLiveMatchesSelection(msg)
 	case viewStats:
 		return m.handleStatsSelection(msg)
+	case viewSchedule:
+		return m.handleScheduleKeys(msg)
+	case viewLeaders:
+		return m.handleLeadersKeys(msg)
 	case viewSettings:
 		return m.handleSettingsViewKeys(msg)
 	}
@@ -348,19 +360,53 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
 	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
+	m.notifyDispatcher.Reset()
+	m.catchUp = nil
+	m.lastSeenMatchID = 0
+	m.showComments = false
 	m.loading = false
 	m.polling = false
 	m.matches = nil
 	m.upcomingMatches = nil
 	m.statsRightPanelFocused = false
 	m.statsScrollOffset = 0
+	m.scheduleLoading = false
+	m.scheduleDateInputActive = false
+	m.scheduleDateInput.Blur()
+	m.leadersLoading = false
 	return m, nil
 }
 
 // handleLiveMatchesSelection handles list navigation in live matches view.
 func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Team pages and box score for the selected game (the list has no t/T/b bindings)
+	if m.liveMatchesList.FilterState() != list.Filtering {
+		if m.liveUpcomingFocused {
+			return m.handleLiveUpcomingKeys(msg)
+		}
+		if updated, cmd, ok := m.handleGameDialogKeys(msg); ok {
+			return updated, cmd
+		}
+		switch msg.String() {
+		case "m":
+			return m.toggleGameMute()
+		case "z":
+			return m.toggleSnooze()
+		case "c":
+			m.catchUp = nil
+			return m, nil
+		case "C":
+			return m.toggleComments()
+		case "tab":
+			// Move to the upcoming games to set tip-off reminders
+			if len(m.liveUpcomingMatches) > 0 {
+				m.liveUpcomingFocused = true
+				m.liveUpcomingCursor = min(m.liveUpcomingCursor, len(m.liveUpcomingMatches)-1)
+			}
+			return m, nil
+		}
+	}
+
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
 	var preUpdateMatchID int
 	if preItem := m.liveMatchesList.SelectedItem(); preItem != nil {
@@ -486,6 +532,10 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Open full statistics dialog
 			m.openStatisticsDialog()
 			return m, nil
+		case "t", "T", "b", "v":
+			// Open the home/away team page, the full box score or the highlights reel
+			updated, cmd, _ := m.handleGameDialogKeys(msg)
+			return updated, cmd
 		}
 	}
 
@@ -563,7 +613,7 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	// Schedule the next refresh (5-min timer)
-	cmds = append(cmds, scheduleLiveRefresh(m.nbaClient, m.useMockData))
+	cmds = append(cmds, scheduleLiveRefresh(m.nbaClient, m.useMockData, m.settings.Refresh.LiveList))
 
 	if len(msg.matches) == 0 {
 		m.liveViewLoading = false
@@ -571,11 +621,8 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
-	for _, match := range msg.matches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
+	// Convert to display format, without the games the conference filter hides
+	displayMatches := m.matchDisplays(msg.matches)
 
 	m.matches = displayMatches
 	m.selected = 0
@@ -587,8 +634,10 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	m.updateLiveListSize()
 
 	if len(displayMatches) > 0 {
-		m.liveMatchesList.Select(0)
-		updatedModel, loadCmd := m.loadMatchDetails(m.matches[0].ID)
+		index := liveEntryIndex(displayMatches)
+		m.selected = index
+		m.liveMatchesList.Select(index)
+		updatedModel, loadCmd := m.loadMatchDetails(m.matches[index].ID)
 		if updatedM, ok := updatedModel.(model); ok {
 			m = updatedM
 		}
@@ -611,7 +660,7 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	// Schedule the next refresh
-	cmds = append(cmds, scheduleLiveRefresh(m.nbaClient, m.useMockData))
+	cmds = append(cmds, scheduleLiveRefresh(m.nbaClient, m.useMockData, m.settings.Refresh.LiveList))
 
 	if len(msg.matches) == 0 {
 		// No live matches - clear list but keep view
@@ -620,11 +669,8 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
-	for _, match := range msg.matches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
+	// Convert to display format, without the games the conference filter hides
+	displayMatches := m.matchDisplays(msg.matches)
 
 	// Preserve current selection if possible
 	currentMatchID := 0
@@ -665,10 +711,7 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 
 	// Update UI immediately with current data
 	if len(m.liveMatchesBuffer) > 0 {
-		displayMatches := make([]ui.MatchDisplay, 0, len(m.liveMatchesBuffer))
-		for _, match := range m.liveMatchesBuffer {
-			displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-		}
+		displayMatches := m.matchDisplays(m.liveMatchesBuffer)
 		m.matches = displayMatches
 		m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
 		m.updateLiveListSize()
@@ -676,8 +719,10 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		// On first batch with matches, select first match and load details
 		if msg.batchIndex == 0 || (len(msg.matches) > 0 && m.matchDetails == nil && len(m.matches) > 0) {
 			if m.selected == 0 && m.matchDetails == nil && len(m.matches) > 0 {
-				m.liveMatchesList.Select(0)
-				updatedModel, loadCmd := m.loadMatchDetails(m.matches[0].ID)
+				index := liveEntryIndex(m.matches)
+				m.selected = index
+				m.liveMatchesList.Select(index)
+				updatedModel, loadCmd := m.loadMatchDetails(m.matches[index].ID)
 				if updatedM, ok := updatedModel.(model); ok {
 					m = updatedM
 				}
@@ -697,7 +742,7 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		}
 
 		// Schedule periodic refresh
-		cmds = append(cmds, scheduleLiveRefresh(m.nbaClient, m.useMockData))
+		cmds = append(cmds, scheduleLiveRefresh(m.nbaClient, m.useMockData, m.settings.Refresh.LiveList))
 
 		return m, tea.Batch(cmds...)
 	}
@@ -836,11 +881,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 		}
 
 		// Populate liveUpcomingMatches for the live view
-		upcomingDisplay := make([]ui.MatchDisplay, 0, len(m.statsData.TodayUpcoming))
-		for _, match := range m.statsData.TodayUpcoming {
-			upcomingDisplay = append(upcomingDisplay, ui.MatchDisplay{Match: match})
-		}
-		m.liveUpcomingMatches = upcomingDisplay
+		m.liveUpcomingMatches = m.matchDisplays(m.statsData.TodayUpcoming)
 	}
 
 	// Track progress
@@ -880,7 +921,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 
 // applyStatsDateFilter applies the current date range filter to the cached stats data.
 // This enables instant switching between Today/3d/5d views without new API calls.
-// All filtering is done client-side from the cached 5-day data based on match MatchTime.
+// All filtering is done client-side from the cached days of data based on match MatchTime.
 func (m *model) applyStatsDateFilter() {
 	if m.statsData == nil {
 		return
@@ -888,28 +929,62 @@ func (m *model) applyStatsDateFilter() {
 
 	// Filter all views from AllFinished based on match's actual MatchTime date
 	var finishedMatches []api.Match
-	switch m.statsDateRange {
-	case 1:
-		// Today only - filter by match date
-		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, 1)
-	case 3:
-		// Last 3 days - filter by match date
-		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, 3)
-	default:
-		// 5 days - use all data
+	if m.statsDateRange < m.statsTotalDays {
+		// Today or the last 3 days - filter by match date
+		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, m.statsDateRange)
+	} else {
+		// Every day loaded - use all data
 		finishedMatches = m.statsData.AllFinished
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(finishedMatches))
-	for _, match := range finishedMatches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
+	// Convert to display format, without the games the conference filter hides
+	displayMatches := m.matchDisplays(finishedMatches)
 	m.matches = displayMatches
 	m.statsMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
 	// Note: Upcoming matches are now shown in the Live view instead
 }
 
+// matchDisplays converts matches to list entries, leaving out the games the
+// conference filter in settings hides. Favorite teams' games come first,
+// otherwise the order is kept.
+func (m model) matchDisplays(matches []api.Match) []ui.MatchDisplay {
+	displays := make([]ui.MatchDisplay, 0, len(matches))
+	for _, match := range matches {
+		if m.settings.ShowsMatch(match) {
+			displays = append(displays, ui.MatchDisplay{Match: match, Favorite: m.settings.IsFavoriteMatch(match)})
+		}
+	}
+	sort.SliceStable(displays, func(i, j int) bool {
+		return displays[i].Favorite && !displays[j].Favorite
+	})
+	return displays
+}
+
+// liveEntryIndex returns the game the live view opens on: the first live game
+// of a favorite team, or the first game.
+func liveEntryIndex(matches []ui.MatchDisplay) int {
+	for i, match := range matches {
+		if match.Favorite && match.Status == api.MatchStatusLive {
+			return i
+		}
+	}
+	return 0
+}
+
+// favoriteTeamIDs returns the NBA team IDs of the favorite teams in settings.
+func (m model) favoriteTeamIDs() []int {
+	if m.settings == nil {
+		return nil
+	}
+	var ids []int
+	for _, tricode := range m.settings.FavoriteTeams {
+		if team, ok := data.TeamByTricode(tricode); ok {
+			ids = append(ids, team.ID)
+		}
+	}
+	return ids
+}
+
 // filterMatchesByDays filters matches to only include those from the last N days.
 // Uses LOCAL time for date comparison so "today" matches user's actual timezone.
 func filterMatchesByDays(matches []api.Match, days int) []api.Match {
@@ -946,7 +1021,7 @@ func (m model) handleAnimationTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 	}
 
 	// Check if any spinner needs to be animated
-	spinnersActive := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.polling
+	spinnersActive := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.scheduleLoading || m.leadersLoading || m.polling
 
 	if !logoAnimating && !spinnersActive {
 		// No animations active - don't continue the tick chain
@@ -962,7 +1037,7 @@ func (m model) handleAnimationTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 		m.randomSpinner.Tick()
 	}
 
-	if m.statsViewLoading {
+	if m.statsViewLoading || m.scheduleLoading || m.leadersLoading {
 		m.statsViewSpinner.Tick()
 	}
 
@@ -1028,6 +1103,27 @@ func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, m.spinner.Tick, ui.SpinnerTick())
 		}
 
+		return m, tea.Batch(cmds...)
+
+	case 2: // Schedule view
+		m.currentView = viewSchedule
+		m.selected = 0
+
+		// Keep spinners running if still loading
+		if m.scheduleLoading || m.statsViewLoading {
+			cmds = append(cmds, m.spinner.Tick, ui.SpinnerTick())
+		}
+
+		return m, tea.Batch(cmds...)
+
+	case 3: // Leaders view
+		m.currentView = viewLeaders
+		m.selected = 0
+
+		if m.leadersLoading {
+			cmds = append(cmds, ui.SpinnerTick())
+		}
+
 		return m, tea.Batch(cmds...)
 	}
 
@@ -1083,6 +1179,8 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 		if upCmd != nil {
 			cmd = tea.Batch(cmd, upCmd)
 		}
+	case viewSchedule:
+		m.scheduleMatchesList, cmd = m.scheduleMatchesList.Update(msg)
 	case viewSettings:
 		if m.settingsState != nil {
 			m.settingsState.List, cmd = m.settingsState.List.Update(msg)
@@ -1092,56 +1190,53 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 	return m, cmd
 }
 
-// notifyNewGoals sends desktop notifications when a goal is scored.
-// Uses score-based detection (more reliable than event ID comparison).
-// Only called during poll refreshes when we have previous score data.
-func (m *model) notifyNewGoals(details *api.MatchDetails) {
-	if m.notifier == nil || details == nil {
-		return
+// notifyGameEvents runs the notification rules on the latest details of the
+// live game, notifying for every event since the previous poll. Delivery runs
+// as a command since webhook and exec sinks can take a while.
+func (m *model) notifyGameEvents(details *api.MatchDetails) tea.Cmd {
+	if m.notifyDispatcher == nil || details == nil {
+		return nil
+	}
+	dispatcher := m.notifyDispatcher
+	return func() tea.Msg {
+		if err := dispatcher.Update(details); err != nil {
+			// Logged only, to not disrupt the app
+			return debugLogMsg{message: fmt.Sprintf("notify: %v", err)}
+		}
+		return nil
 	}
+}
 
-	// Get current scores
-	homeScore := 0
-	awayScore := 0
-	if details.HomeScore != nil {
-		homeScore = *details.HomeScore
-	}
-	if details.AwayScore != nil {
-		awayScore = *details.AwayScore
+// toggleGameMute mutes or unmutes notifications for the game in the details panel.
+func (m model) toggleGameMute() (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil || m.notifyDispatcher == nil {
+		return m, nil
 	}
+	id := m.matchDetails.ID
+	muted := !m.notifyDispatcher.Muted(id)
+	m.notifyDispatcher.SetMuted(id, muted)
 
-	// Check if score increased (goal scored)
-	homeGoalScored := homeScore > m.lastHomeScore
-	awayGoalScored := awayScore > m.lastAwayScore
-
-	if !homeGoalScored && !awayGoalScored {
-		return
+	matchup := m.matchDetails.AwayTeam.ShortName + " @ " + m.matchDetails.HomeTeam.ShortName
+	status := fmt.Sprintf(constants.StatusGameUnmuted, matchup)
+	if muted {
+		status = fmt.Sprintf(constants.StatusGameMuted, matchup)
 	}
+	return m, m.liveMatchesList.NewStatusMessage(status)
+}
 
-	// Find the most recent scoring event to get player details
-	var goalEvent *api.MatchEvent
-	for i := len(details.Events) - 1; i >= 0; i-- {
-		event := details.Events[i]
-		eventType := strings.ToLower(event.Type)
-		// Detect football goals and NBA scoring events
-		isScoreEvent := eventType == "goal" || eventType == "field_goal" || eventType == "free_throw"
-		if isScoreEvent {
-			// Check if this event matches the team that scored
-			if homeGoalScored && event.Team.ID == details.HomeTeam.ID {
-				goalEvent = &event
-				break
-			}
-			if awayGoalScored && event.Team.ID == details.AwayTeam.ID {
-				goalEvent = &event
-				break
-			}
-		}
+// toggleSnooze snoozes every notification for notify.SnoozeDuration, or ends the snooze.
+func (m model) toggleSnooze() (tea.Model, tea.Cmd) {
+	until := time.Time{}
+	status := constants.StatusSnoozeEnded
+	if notify.SnoozedUntil().IsZero() {
+		until = time.Now().Add(notify.SnoozeDuration)
+		status = fmt.Sprintf(constants.StatusSnoozed, until.Format("15:04"))
 	}
-
-	if goalEvent != nil {
-		// Send notification - errors are silently ignored to not disrupt the app
-		_ = m.notifier.Goal(*goalEvent, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
+	if err := notify.Snooze(until); err != nil {
+		m.debugLog(fmt.Sprintf("notify: %v", err))
+		return m, nil
 	}
+	return m, m.liveMatchesList.NewStatusMessage(status)
 }
 
 // max returns the larger of two integers.
@@ -1152,40 +1247,91 @@ func max(a, b int) int {
 	return b
 }
 
-// handleGoalLinks processes goal replay links fetched from Reddit.
-func (m model) handleGoalLinks(msg goalLinksMsg) (tea.Model, tea.Cmd) {
-	m.debugLog(fmt.Sprintf("handleGoalLinks called for match %d with %d links", msg.matchID, len(msg.links)))
-	if len(msg.links) == 0 {
-		m.debugLog(fmt.Sprintf("GoalLinks completed for match %d: no links found", msg.matchID))
-		return m, nil
+// handleHighlights processes play highlight links fetched from Reddit.
+func (m model) handleHighlights(msg highlightsMsg) (tea.Model, tea.Cmd) {
+	delete(m.highlightSearches, msg.matchID)
+	m.debugLog(fmt.Sprintf("Highlights completed for match %d: %d plays with clips", msg.matchID, len(msg.clips)))
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("Highlight providers failed: %v", msg.err))
 	}
 
-	m.debugLog(fmt.Sprintf("GoalLinks completed for match %d: processing %d links", msg.matchID, len(msg.links)))
-
-	// Merge new links into the goal links map
-	if m.goalLinks == nil {
-		m.goalLinks = make(map[reddit.GoalLinkKey]*reddit.GoalLink)
+	m.storeHighlights(msg.clips)
+	if dialog := m.highlightsDialog(msg.matchID); dialog != nil {
+		dialog.SetPlays(m.highlightReel(msg.matchID))
+		dialog.SetStatus("")
 	}
+	return m, nil
+}
 
-	validLinks := 0
-	failedLinks := 0
-
-	for key, link := range msg.links {
-		m.goalLinks[key] = link
-		if link != nil && link.URL != "" && link.URL != "__NOT_FOUND__" {
-			validLinks++
-			m.debugLog(fmt.Sprintf("Cached goal link: %d:%d → %s (post: %s)", key.MatchID, key.Minute, link.URL, link.PostURL))
-		} else if link != nil && link.URL == "__NOT_FOUND__" {
-			failedLinks++
-			m.debugLog(fmt.Sprintf("No link found: %d:%d", key.MatchID, key.Minute))
+// storeHighlights keeps the clips with a valid URL of each play, replacing
+// the ones it had.
+func (m *model) storeHighlights(found map[reddit.PlayKey][]highlights.Clip) {
+	if m.highlights == nil {
+		m.highlights = make(map[reddit.PlayKey][]highlights.Clip)
+	}
+	for key, clips := range found {
+		var valid []highlights.Clip
+		for _, clip := range clips {
+			if ui.IsValidReplayURL(clip.URL) {
+				valid = append(valid, clip)
+			}
+		}
+		if len(valid) > 0 {
+			m.highlights[key] = valid
+			m.debugLog(fmt.Sprintf("Cached highlight: %s → %s (%s)", key, valid[0].URL, valid[0].Source))
 		}
 	}
+}
 
-	m.debugLog(fmt.Sprintf("Goal link batch complete: %d valid, %d failed", validLinks, failedLinks))
+// searchHighlights returns the command asking the highlight providers for
+// clips of the notable plays among a game's events, or nil when there are
+// none or a search for the game is still running. Mock games are not searched.
+func (m *model) searchHighlights(details *api.MatchDetails, events []api.MatchEvent) tea.Cmd {
+	plays := nba.NotablePlays(details, events)
+	if m.notablePlays != nil {
+		m.notablePlays[details.ID] = plays
+	}
+	if m.highlightProviders == nil || m.useMockData || m.highlightSearches[details.ID] || len(plays) == 0 {
+		return nil
+	}
+	m.highlightSearches[details.ID] = true
+	return fetchHighlights(m.highlightProviders, details, plays)
+}
 
+// handleGameThreads stores a game's r/nba thread links.
+func (m model) handleGameThreads(msg gameThreadsMsg) (tea.Model, tea.Cmd) {
+	delete(m.threadSearches, msg.matchID)
+	if msg.threads != nil {
+		m.gameThreads[msg.matchID] = msg.threads
+	}
 	return m, nil
 }
 
+// searchGameThreads returns the command finding a game's r/nba threads, or
+// nil when a search for it is still running. Games not started have no
+// threads yet and mock games are not searched.
+func (m *model) searchGameThreads(details *api.MatchDetails) tea.Cmd {
+	if m.redditClient == nil || m.useMockData || m.threadSearches[details.ID] ||
+		details.Status == api.MatchStatusNotStarted {
+		return nil
+	}
+	m.threadSearches[details.ID] = true
+	return fetchGameThreads(m.redditClient, details)
+}
+
+// threadLinks returns the r/nba thread links of the game in the details
+// panel, or nil when none has been found.
+func (m model) threadLinks() *ui.ThreadLinks {
+	if m.matchDetails == nil {
+		return nil
+	}
+	threads := m.gameThreads[m.matchDetails.ID]
+	if threads == nil || (threads.GameThread == "" && threads.PostGameThread == "") {
+		return nil
+	}
+	return &ui.ThreadLinks{GameThread: threads.GameThread, PostGameThread: threads.PostGameThread}
+}
+
 // debugLog writes debug messages to a log file without interfering with the UI
 // Only writes when debug mode is enabled. Implements log rotation to prevent excessive growth.
 func (m model) debugLog(message string) {
@@ -1193,13 +1339,11 @@ func (m model) debugLog(message string) {
 		return // Silently skip if debug mode is not enabled
 	}
 
-	configDir, err := data.ConfigDir()
+	logFile, err := data.DebugLogPath()
 	if err != nil {
-		return // Silently fail if we can't get config dir
+		return // Silently fail if we can't get the state dir
 	}
 
-	logFile := filepath.Join(configDir, "golazo_debug.log")
-
 	// Check file size and rotate if necessary
 	if err := m.rotateDebugLogIfNeeded(logFile); err != nil {
 		return // Silently fail if rotation fails
@@ -1312,20 +1456,6 @@ func (m model) trimDebugLogToMaxLines(logFile string, maxLines int) error {
 	return os.WriteFile(logFile, []byte(strings.Join(lines, "\n")), 0644)
 }
 
-// GoalReplayURL returns the replay URL for a goal if available.
-// Returns empty string if no replay link is cached.
-func (m *model) GoalReplayURL(matchID, minute int) string {
-	if m.goalLinks == nil {
-		return ""
-	}
-
-	key := reddit.GoalLinkKey{MatchID: matchID, Minute: minute}
-	if link, ok := m.goalLinks[key]; ok && link != nil {
-		return link.URL
-	}
-	return ""
-}
-
 // openFormationsDialog opens the formations dialog for the current match.
 func (m *model) openFormationsDialog() {
 	if m.matchDetails == nil || m.dialogOverlay == nil {
@@ -1374,6 +1504,7 @@ func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 		msg.homeTeamID,
 		msg.awayTeamID,
 	)
+	dialog.SetFavorites(m.favoriteTeamIDs())
 	m.dialogOverlay.OpenDialog(dialog)
 	m.debugLog(fmt.Sprintf("handleStandings: dialog opened, HasDialogs=%v", m.dialogOverlay.HasDialogs()))
 
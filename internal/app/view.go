@@ -3,7 +3,8 @@ package app
 import (
 	"fmt"
 
-	"github.com/gabriel7419/courtside/internal/reddit"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/nba"
 	"github.com/gabriel7419/courtside/internal/ui"
 )
 
@@ -39,8 +40,11 @@ func (m model) View() string {
 			m.liveTotalBatches,
 			m.pollingSpinner,
 			m.polling,
-			m.liveUpcomingMatches,
+			m.liveUpcomingDisplay(),
 			m.buildGoalLinksMap(),
+			m.threadLinks(),
+			m.commentsPanel(),
+			m.catchUp,
 			m.getStatusBannerType(),
 		)
 
@@ -57,12 +61,60 @@ func (m model) View() string {
 			m.statsDaysLoaded,
 			m.statsTotalDays,
 			m.buildGoalLinksMap(),
+			m.threadLinks(),
 			m.getStatusBannerType(),
 			&m.statsDetailsViewport,
 			m.statsRightPanelFocused,
 			m.statsScrollOffset,
 		)
 
+	case viewSchedule:
+		m.ensureScheduleListSize()
+		var liveUpdates []string
+		if m.matchDetails != nil && m.matchDetails.Status == api.MatchStatusLive {
+			liveUpdates = m.parser.ParseEvents(m.matchDetails.Events, m.matchDetails.HomeTeam, m.matchDetails.AwayTeam)
+		}
+		return ui.RenderScheduleView(ui.ScheduleViewConfig{
+			Width:           m.width,
+			Height:          m.height,
+			List:            m.scheduleMatchesList,
+			Date:            m.scheduleDate,
+			Selected:        m.selectedScheduleMatch(),
+			Details:         m.matchDetails,
+			Preview:         m.schedulePreview,
+			LiveUpdates:     liveUpdates,
+			GoalLinks:       m.buildGoalLinksMap(),
+			Threads:         m.threadLinks(),
+			DateInput:       m.scheduleDateInput.View(),
+			DateInputActive: m.scheduleDateInputActive,
+			DateInputHint:   m.scheduleDateInputHint,
+			Spinner:         m.ensureStatsSpinner(),
+			Loading:         m.scheduleLoading || m.statsViewLoading,
+			DayIsLoading:    m.scheduleLoading,
+			BannerType:      m.getStatusBannerType(),
+			RightFocused:    m.statsRightPanelFocused,
+			ScrollOffset:    m.statsScrollOffset,
+			Reminder:        m.scheduleReminder(),
+		})
+
+	case viewLeaders:
+		return ui.RenderLeadersView(ui.LeadersViewConfig{
+			Width:          m.width,
+			Height:         m.height,
+			Categories:     nba.LeaderCategories,
+			Category:       nba.LeaderCategories[m.leadersCategory],
+			Mode:           m.leadersMode,
+			Season:         m.leadersSeason,
+			Playoffs:       m.leadersPlayoffs,
+			Leaders:        m.leaders,
+			PlayingTonight: m.teamsPlayingToday(),
+			Cursor:         m.leadersCursor,
+			Spinner:        m.ensureStatsSpinner(),
+			Loading:        m.leadersLoading,
+			Failed:         m.leadersFailed,
+			BannerType:     m.getStatusBannerType(),
+		})
+
 	case viewSettings:
 		return ui.RenderSettingsView(m.width, m.height, m.settingsState, m.getStatusBannerType())
 
@@ -117,6 +169,30 @@ func (m *model) ensureStatsListSize() {
 	}
 }
 
+// ensureScheduleListSize ensures schedule list dimensions are set before rendering.
+func (m *model) ensureScheduleListSize() {
+	if m.width <= 0 || m.height <= 0 {
+		return
+	}
+
+	const (
+		frameH          = 2
+		frameV          = 2
+		titleHeight     = 3
+		spinnerHeight   = 3
+		headerHeight    = 2 // "Schedule" header + spacing
+		navigatorHeight = 4 // Day navigator, hint/input + spacing
+	)
+
+	leftWidth := max(m.width*35/100, 25)
+	availableWidth := leftWidth - frameH*2
+	availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight - headerHeight - navigatorHeight
+
+	if availableWidth > 0 && availableHeight > 0 {
+		m.scheduleMatchesList.SetSize(availableWidth, availableHeight)
+	}
+}
+
 // ensureStatsSpinner ensures stats spinner is initialized.
 func (m *model) ensureStatsSpinner() *ui.RandomCharSpinner {
 	if m.statsViewSpinner == nil {
@@ -126,23 +202,19 @@ func (m *model) ensureStatsSpinner() *ui.RandomCharSpinner {
 	return m.statsViewSpinner
 }
 
-// buildGoalLinksMap converts the model's goal links to a UI-friendly map.
-// Also triggers fetching for any goals that exist in match details but are not cached.
+// buildGoalLinksMap converts the model's highlight links to a UI-friendly map
+// keyed by the play's period and clock.
 func (m *model) buildGoalLinksMap() ui.GoalLinksMap {
-	if len(m.goalLinks) == 0 {
+	if len(m.highlights) == 0 {
 		return nil
 	}
 
 	result := make(ui.GoalLinksMap)
-	for key, link := range m.goalLinks {
-		// Filter out "__NOT_FOUND__" and invalid URLs using helper function
-		if link != nil && ui.IsValidReplayURL(link.URL) {
-			uiKey := ui.MakeGoalLinkKey(key.MatchID, key.Minute)
-			result[uiKey] = link.URL
+	for key, clips := range m.highlights {
+		// The best clip; clips are kept only with a valid URL
+		if len(clips) > 0 {
+			result[ui.MakeGoalLinkKey(key.MatchID, key.Period, key.Clock)] = clips[0].URL
 		}
 	}
 	return result
 }
-
-// Ensure reddit.GoalLinkKey is used (avoid unused import)
-var _ reddit.GoalLinkKey
@@ -18,4 +18,8 @@ const (
 	StatusBannerNewVersion
 	// StatusBannerDev indicates this is a development build.
 	StatusBannerDev
+	// StatusBannerMigrated indicates the first run after the move out of the Golazo directories.
+	StatusBannerMigrated
+	// StatusBannerSettings indicates settings.yaml or the environment has problems.
+	StatusBannerSettings
 )
@@ -4,20 +4,27 @@ package constants
 const (
 	MenuStats       = "Finished Games"
 	MenuLiveMatches = "Live Games"
+	MenuSchedule    = "Schedule"
+	MenuLeaders     = "League Leaders"
 	MenuSettings    = "Settings"
 )
 
 // Panel titles
 const (
-	PanelLiveMatches       = "Live Games"
-	PanelFinishedMatches   = "Finished Games"
-	PanelMatchDetails      = "Game Details"
-	PanelMatchList         = "Game List"
-	PanelUpcomingMatches   = "Upcoming Games"
-	PanelPlayByPlay        = "Play-by-play"
-	PanelGameStatistics    = "Game Statistics"
-	PanelUpdates           = "Live Updates"
-	PanelLeaguePreferences = "Conference Preferences"
+	PanelLiveMatches     = "Live Games"
+	PanelFinishedMatches = "Finished Games"
+	PanelMatchDetails    = "Game Details"
+	PanelMatchList       = "Game List"
+	PanelUpcomingMatches = "Upcoming Games"
+	PanelSchedule        = "Schedule"
+	PanelLeaders         = "League Leaders"
+	PanelPlayByPlay      = "Play-by-play"
+	PanelGameStatistics  = "Game Statistics"
+	PanelUpdates         = "Live Updates"
+	PanelMilestoneWatch  = "Milestone Watch"
+	PanelCatchUp         = "Since You Left"
+	PanelGameThread      = "r/nba Game Thread"
+	PanelSettings        = "Favorites & Filters"
 )
 
 // Backward-compat aliases (used in older callers)
@@ -28,24 +35,90 @@ const (
 
 // Empty state messages
 const (
-	EmptyNoLiveMatches     = "No live games right now"
-	EmptyNoFinishedMatches = "No finished games"
-	EmptySelectMatch       = "Select a game"
-	EmptyNoUpdates         = "No play-by-play yet"
-	EmptyNoMatches         = "No games available"
+	EmptyNoLiveMatches      = "No live games right now"
+	EmptyNoFinishedMatches  = "No finished games"
+	EmptySelectMatch        = "Select a game"
+	EmptyNoUpdates          = "No play-by-play yet"
+	EmptyNoMatches          = "No games available"
+	EmptyNoScheduledGames   = "No games scheduled"
+	EmptyNoRoster           = "Roster unavailable"
+	EmptyNoTeamAverages     = "Season averages unavailable"
+	EmptyNoRecentResults    = "No games played yet"
+	EmptyNoBoxScore         = "No box score available"
+	EmptyNoPlayerAverages   = "No averages this season"
+	EmptyNoGameLog          = "No games logged this season"
+	EmptyNoLeaders          = "No leaders for this season yet"
+	EmptyLeadersUnavailable = "Leaders unavailable — press r to retry"
+	EmptyNoSeasonSeries     = "No meetings scheduled this season"
+	EmptyNoNotifications    = "No notifications yet"
+)
+
+// Notification status messages
+const (
+	StatusGameMuted       = "🔕 Notifications muted for %s"
+	StatusGameUnmuted     = "🔔 Notifications on for %s"
+	StatusSnoozed         = "🔕 Notifications snoozed until %s"
+	StatusSnoozeEnded     = "🔔 Snooze ended"
+	StatusReminderSet     = "⏰ Reminder set for %s, %d min before tip-off"
+	StatusReminderRemoved = "Reminder removed for %s"
+)
+
+// r/nba discussion threads in the match details header and comments panel
+const (
+	LinkGameThread        = "Game Thread"
+	LinkPostGameThread    = "Post Game Thread"
+	HelpThreadLinks       = "o: open"
+	EmptyNoComments       = "No comments yet"
+	StatusFindingThread   = "Looking for the game thread..."
+	StatusCommentsLoading = "Loading comments..."
+	StatusCommentsBlocked = "Reddit is limiting requests — retrying in a few minutes"
+	StatusCommentsFailed  = "Comments unavailable — retrying"
+)
+
+// Highlights reel dialog
+const (
+	EmptyNoNotablePlays       = "No notable plays yet"
+	EmptyNoClip               = "no clip found"
+	StatusSearchingHighlights = "Searching for clips..."
+	StatusLinkCopied          = "Link copied"
+	StatusCopyFailed          = "Couldn't copy the link: %v"
 )
 
 // Help text
 const (
-	HelpMainMenu           = "↑/↓: navigate  Enter: select  q: quit"
+	HelpMainMenu           = "↑/↓: navigate  Enter: select  n: notifications  q: quit"
 	HelpMatchesView        = "↑/↓: navigate  r: refresh  /: filter  Esc: back  q: quit"
 	HelpSettingsView       = "↑/↓: navigate  ←/→: switch tabs  Space: toggle  /: filter  Enter: save  Esc: back"
 	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh  /: filter  Esc: back"
 	HelpStatsViewUnfocused = "Tab: focus details"
-	HelpStatsViewFocused   = "Tab: unfocus  s: standings  x: all statistics  ↑/↓: scroll"
-	HelpStandingsDialog    = "Esc: close"
+	HelpStatsViewFocused   = "Tab: unfocus  s: standings  x: stats  b: box score  t/T: teams  o: thread  v: clips  ↑/↓: scroll"
+	HelpCatchUp            = "c: dismiss"
+	HelpScheduleView       = "h/l: day  t: today  g: date  a: remind"
+	HelpScheduleDateInput  = "YYYY-MM-DD, MM/DD, ±N  Enter: go  Esc: cancel"
+	HelpLeadersView        = "h/l: category  m: mode  [/]: season  p: playoffs  Enter: player  r: refresh  Esc: back"
+	HelpStandingsDialog    = "↑/↓: navigate  Enter: team page  Esc: close"
+	HelpBoxScoreDialog     = "Tab/←/→: switch team  ↑/↓: navigate  Enter: player profile  Esc: close"
+	HelpPlayerDialog       = "↑/↓: scroll game log  Esc: close"
+	HelpTeamDialog         = "Tab/←/→: switch tab  ↑/↓: navigate  Enter: open game  Esc: close"
 	HelpFormationsDialog   = "Tab/←/→: switch team  Esc: close"
 	HelpStatisticsDialog   = "↑/↓: navigate  Esc: close"
+	HelpInboxDialog        = "↑/↓: navigate  Enter: open game  r: mark read  a: mark all read  Esc: close"
+	HelpHighlightsDialog   = "↑/↓: navigate  Enter: open clip  p: post  y: copy  r: search missing  Esc: close"
+)
+
+// Settings view tabs
+const (
+	SettingsTabEast  = "Eastern"
+	SettingsTabWest  = "Western"
+	SettingsTabLists = "Game Lists"
+)
+
+// Team page tabs
+const (
+	TeamTabSchedule = "Schedule"
+	TeamTabRecent   = "Last 10"
+	TeamTabRoster   = "Roster"
+	TeamTabAverages = "Averages"
 )
 
 // Status text
@@ -67,13 +140,39 @@ const (
 	// NotificationTitleGoal is shown in scoring notifications.
 	NotificationTitleGoal  = "🏀 Courtside!"
 	NotificationTitleScore = "🏀 Score!"
+
+	NotificationTitleTipOff     = "🏀 Tip-off"
+	NotificationTitleCorrection = "🏀 Score correction"
+	NotificationTitlePeriod     = "🏀 End of period"
+	NotificationTitleFinal      = "🏀 Final"
+	NotificationTitleOvertime   = "🏀 Overtime!"
+	NotificationTitleMomentum   = "🏀 Momentum"
+	NotificationTitleEjection   = "🏀 Ejection"
+	NotificationTitleMilestone  = "🏀 Milestone"
+	NotificationTitleRule       = "🏀 Alert"
+	NotificationTitleSummary    = "🏀 While you were away"
+	NotificationTitleReminder   = "🏀 Tip-off soon"
 )
 
 // Stats labels
 const (
-	LabelStatus = "Status: "
-	LabelScore  = "Score: "
-	LabelLeague = "Conference: "
-	LabelDate   = "Date: "
-	LabelVenue  = "Arena: "
+	LabelStatus   = "Status: "
+	LabelScore    = "Score: "
+	LabelLeague   = "Conference: "
+	LabelDate     = "Date: "
+	LabelVenue    = "Arena: "
+	LabelTipOff   = "Tip-off: "
+	LabelTV       = "TV: "
+	LabelReminder = "Reminder: "
+
+	LegendPlayingToday = "● plays today"
+)
+
+// Pre-game preview sections
+const (
+	PreviewStandings    = "Standings"
+	PreviewLastFive     = "Last 5"
+	PreviewSeasonSeries = "Season series"
+	PreviewStarters     = "Projected starters (last game)"
+	PreviewTopScorers   = "Top scorers (PTS/REB/AST)"
 )
@@ -0,0 +1,205 @@
+// Package daemon runs the background notification watcher behind
+// `courtside notify-daemon`: it polls the night's scoreboard, follows every
+// live or favorited game and feeds each update to the notification rules.
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/notify"
+)
+
+// Source is the game data the daemon polls. *nba.Client satisfies it, so the
+// daemon shares the client's response cache and rate limiter.
+type Source interface {
+	MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error)
+	MatchDetails(ctx context.Context, matchID int, fallbackMatch *api.Match) (*api.MatchDetails, error)
+}
+
+// Daemon watches one night of games.
+type Daemon struct {
+	source     Source
+	dispatcher *notify.Dispatcher
+	favorites  []string
+	interval   time.Duration
+	logf       func(format string, args ...any)
+
+	reminders       *notify.Reminders // nil when reminders are disabled
+	reminderMinutes int
+
+	watched map[int]bool // games seen before they finished
+}
+
+// New creates a daemon polling source every interval and delivering through dispatcher;
+// an interval of 0 uses the default refresh.daemon setting.
+// favorites are team tricodes whose games are followed from before tip-off.
+// logf receives progress messages; nil discards them.
+func New(source Source, dispatcher *notify.Dispatcher, favorites []string, interval time.Duration, logf func(format string, args ...any)) *Daemon {
+	if interval <= 0 {
+		interval = data.DefaultSettings().Refresh.Daemon
+	}
+	if logf == nil {
+		logf = func(string, ...any) {}
+	}
+	return &Daemon{
+		source:     source,
+		dispatcher: dispatcher,
+		favorites:  favorites,
+		interval:   interval,
+		logf:       logf,
+		watched:    make(map[int]bool),
+	}
+}
+
+// SetReminders makes the daemon fire due tip-off reminders at every poll,
+// with minutes as the default lead time.
+func (d *Daemon) SetReminders(r *notify.Reminders, minutes int) {
+	d.reminders = r
+	d.reminderMinutes = minutes
+}
+
+// Run polls until every game of the night has ended or ctx is cancelled.
+// The night is the local calendar day the daemon was started on. Games of the
+// night before are followed too while they are live, since late games run
+// past midnight.
+func (d *Daemon) Run(ctx context.Context) error {
+	night := nightOf(time.Now())
+	previous := night.AddDate(0, 0, -1)
+	followPrevious := true
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		// Quiet hours or a snooze may have ended since the last poll
+		if err := d.dispatcher.Flush(); err != nil {
+			d.logf("notify: %v", err)
+		}
+		d.fireReminders()
+
+		if followPrevious {
+			done, err := d.poll(ctx, previous, false)
+			if err != nil {
+				d.logf("poll of %s failed: %v", previous.Format("Mon 02 Jan"), err)
+			}
+			followPrevious = !done
+		}
+
+		done, err := d.poll(ctx, night, true)
+		switch {
+		case err != nil:
+			d.logf("poll failed: %v", err)
+		case done && !followPrevious:
+			d.logf("no games left tonight, exiting")
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// nightOf returns the night of games on t's local calendar day. Noon keeps
+// the scoreboard's UTC date on the local day (see app.scheduleDay).
+func nightOf(t time.Time) time.Time {
+	y, mo, day := t.Date()
+	return time.Date(y, mo, day, 12, 0, 0, 0, time.Local)
+}
+
+// poll fetches the scoreboard and updates every followed game.
+// done reports that no game of the night is left to play. Games that haven't
+// started are waited for only when scheduled is set; the night before has
+// none left to play but postponed ones.
+func (d *Daemon) poll(ctx context.Context, night time.Time, scheduled bool) (done bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, d.interval)
+	defer cancel()
+
+	matches, err := d.source.MatchesByDate(ctx, night)
+	if err != nil {
+		return false, fmt.Errorf("fetch scoreboard: %w", err)
+	}
+	if len(matches) == 0 && scheduled {
+		d.logf("no games scheduled on %s", night.Format("Mon 02 Jan"))
+	}
+
+	done = true
+	for _, match := range matches {
+		switch match.Status {
+		case api.MatchStatusNotStarted:
+			if !scheduled {
+				continue
+			}
+			done = false
+			// Scheduled favorites get a baseline so their tip-off notifies
+			if d.isFavorite(match) {
+				d.update(&api.MatchDetails{Match: match})
+			}
+		case api.MatchStatusLive:
+			done = false
+			d.follow(ctx, match)
+		case api.MatchStatusFinished:
+			// One last update for games that ended since the previous poll
+			if d.watched[match.ID] {
+				d.follow(ctx, match)
+				delete(d.watched, match.ID)
+			}
+		}
+	}
+	return done, nil
+}
+
+// follow fetches a game's details and runs the rules on them.
+func (d *Daemon) follow(ctx context.Context, match api.Match) {
+	details, err := d.source.MatchDetails(ctx, match.ID, &match)
+	if err != nil {
+		d.logf("%s: %v", matchup(match), err)
+		return
+	}
+	if !d.watched[match.ID] && match.Status != api.MatchStatusFinished {
+		d.logf("following %s", matchup(match))
+	}
+	d.watched[match.ID] = true
+	d.update(details)
+}
+
+// fireReminders sends the tip-off reminders that are due.
+func (d *Daemon) fireReminders() {
+	if d.reminders == nil {
+		return
+	}
+	err := d.reminders.Fire(time.Now(), d.reminderMinutes, func(rem notify.Reminder) error {
+		d.logf("reminder for %s @ %s", rem.AwayTeam.ShortName, rem.HomeTeam.ShortName)
+		return d.dispatcher.Send(notify.NewReminderEvent(rem, time.Now()))
+	})
+	if err != nil {
+		d.logf("reminders: %v", err)
+	}
+}
+
+// update runs the notification rules on one snapshot of a game.
+func (d *Daemon) update(details *api.MatchDetails) {
+	if err := d.dispatcher.Update(details); err != nil {
+		d.logf("%s: notify: %v", matchup(details.Match), err)
+	}
+}
+
+// isFavorite reports whether either team is a favorite.
+func (d *Daemon) isFavorite(match api.Match) bool {
+	return slices.ContainsFunc(d.favorites, func(t string) bool {
+		return strings.EqualFold(t, match.HomeTeam.ShortName) || strings.EqualFold(t, match.AwayTeam.ShortName)
+	})
+}
+
+// matchup renders "MIA @ BOS".
+func matchup(match api.Match) string {
+	return fmt.Sprintf("%s @ %s", match.AwayTeam.ShortName, match.HomeTeam.ShortName)
+}
@@ -0,0 +1,166 @@
+package daemon
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/notify"
+)
+
+// fakeSource serves one scripted scoreboard per poll of each night; the last
+// one is served again once the script runs out.
+type fakeSource struct {
+	mu     sync.Mutex
+	nights map[string][][]api.Match // by date, "2006-01-02"
+	polls  map[string]int
+}
+
+func (s *fakeSource) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	day := date.Format(time.DateOnly)
+	script := s.nights[day]
+	if len(script) == 0 {
+		return nil, nil
+	}
+	n := min(s.polls[day], len(script)-1)
+	s.polls[day]++
+	return script[n], nil
+}
+
+func (s *fakeSource) MatchDetails(ctx context.Context, matchID int, fallbackMatch *api.Match) (*api.MatchDetails, error) {
+	return &api.MatchDetails{Match: *fallbackMatch}, nil
+}
+
+// recordingNotifier keeps the events it is sent.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	events []notify.EventType
+}
+
+func (r *recordingNotifier) Notify(e notify.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e.Type)
+	return nil
+}
+
+// testMatch returns game id, MIA @ BOS, in the given state.
+func testMatch(id int, status api.MatchStatus, period, home, away int) api.Match {
+	match := api.Match{
+		ID:       id,
+		HomeTeam: api.Team{ID: 1, ShortName: "BOS"},
+		AwayTeam: api.Team{ID: 2, ShortName: "MIA"},
+		Status:   status,
+	}
+	if status != api.MatchStatusNotStarted {
+		clock := "6:00"
+		match.Quarter, match.Clock = &period, &clock
+		match.HomeScore, match.AwayScore = &home, &away
+	}
+	return match
+}
+
+func TestRunFollowsTheNight(t *testing.T) {
+	tonight := time.Now().Format(time.DateOnly)
+	lastNight := time.Now().AddDate(0, 0, -1).Format(time.DateOnly)
+	tests := []struct {
+		name      string
+		favorites []string
+		nights    map[string][][]api.Match
+		want      []notify.EventType
+	}{
+		{
+			name:      "favorite from before tip-off to the final",
+			favorites: []string{"bos"},
+			nights: map[string][][]api.Match{tonight: {
+				{testMatch(1, api.MatchStatusNotStarted, 0, 0, 0)},
+				{testMatch(1, api.MatchStatusLive, 1, 0, 0)},
+				{testMatch(1, api.MatchStatusLive, 1, 2, 0)},
+				{testMatch(1, api.MatchStatusFinished, 4, 2, 0)},
+			}},
+			want: []notify.EventType{notify.EventTipOff, notify.EventScore, notify.EventFinal},
+		},
+		{
+			name: "other games from when they are live",
+			nights: map[string][][]api.Match{tonight: {
+				{testMatch(1, api.MatchStatusNotStarted, 0, 0, 0)},
+				{testMatch(1, api.MatchStatusLive, 1, 0, 0)},
+				{testMatch(1, api.MatchStatusLive, 1, 2, 0)},
+				{testMatch(1, api.MatchStatusFinished, 4, 2, 0)},
+			}},
+			want: []notify.EventType{notify.EventScore, notify.EventFinal},
+		},
+		{
+			name: "last night's game running past midnight",
+			nights: map[string][][]api.Match{lastNight: {
+				{testMatch(1, api.MatchStatusLive, 4, 100, 99)},
+				{testMatch(1, api.MatchStatusFinished, 4, 100, 99)},
+			}},
+			want: []notify.EventType{notify.EventFinal},
+		},
+		{
+			name: "nothing to follow",
+			nights: map[string][][]api.Match{
+				lastNight: {{testMatch(1, api.MatchStatusFinished, 4, 100, 99)}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_STATE_HOME", t.TempDir())
+			notifier := &recordingNotifier{}
+			source := &fakeSource{nights: tt.nights, polls: make(map[string]int)}
+			d := New(source, notify.NewDispatcher(notifier, nil), tt.favorites, time.Millisecond, nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := d.Run(ctx); err != nil {
+				t.Fatalf("Run() = %v", err)
+			}
+			if ctx.Err() != nil {
+				t.Fatal("Run() only returned when cancelled, want it to end with the night")
+			}
+			if !slices.Equal(notifier.events, tt.want) {
+				t.Errorf("notified %v, want %v", notifier.events, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunStopsWhenCancelled(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	tonight := time.Now().Format(time.DateOnly)
+	source := &fakeSource{
+		nights: map[string][][]api.Match{tonight: {{testMatch(1, api.MatchStatusNotStarted, 0, 0, 0)}}},
+		polls:  make(map[string]int),
+	}
+	d := New(source, notify.NewDispatcher(&recordingNotifier{}, nil), nil, time.Millisecond, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- d.Run(ctx) }()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() = %v, want nil on cancellation", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() still polling after cancellation")
+	}
+}
+
+func TestNewDefaultInterval(t *testing.T) {
+	d := New(&fakeSource{}, notify.NewDispatcher(&recordingNotifier{}, nil), nil, 0, nil)
+	if want := data.DefaultSettings().Refresh.Daemon; d.interval != want {
+		t.Errorf("interval = %v, want the refresh.daemon default %v", d.interval, want)
+	}
+}
@@ -0,0 +1,81 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// lockFileName is the PID file that keeps a second daemon from starting.
+const lockFileName = "notify-daemon.pid"
+
+// LockPath returns the path of the daemon's PID file in the state directory.
+func LockPath() (string, error) {
+	dir, err := data.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, lockFileName), nil
+}
+
+// AcquireLock creates the PID file at path holding this process's PID.
+// A file left behind by a process that is no longer running is replaced.
+// The returned release removes the file.
+func AcquireLock(path string) (release func(), err error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_, err = fmt.Fprintf(f, "%d\n", os.Getpid())
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(path)
+				return nil, fmt.Errorf("write lock file: %w", err)
+			}
+			return func() { _ = os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("create lock file: %w", err)
+		}
+
+		if pid, ok := lockOwner(path); ok && processAlive(pid) {
+			return nil, fmt.Errorf("notify-daemon is already running (pid %d, lock %s)", pid, path)
+		}
+		// Stale lock from a daemon that did not shut down cleanly
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("remove stale lock file: %w", err)
+		}
+	}
+	return nil, fmt.Errorf("could not acquire lock file %s", path)
+}
+
+// lockOwner reads the PID stored in the lock file.
+func lockOwner(path string) (int, bool) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	return pid, err == nil && pid > 0
+}
+
+// processAlive reports whether a process with pid is running.
+// On Windows FindProcess itself fails for unknown PIDs; elsewhere signal 0 probes it.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return p.Signal(syscall.Signal(0)) == nil
+}
@@ -0,0 +1,61 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAcquireLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), lockFileName)
+	release, err := AcquireLock(path)
+	if err != nil {
+		t.Fatalf("AcquireLock() = %v", err)
+	}
+	if pid, ok := lockOwner(path); !ok || pid != os.Getpid() {
+		t.Errorf("lock owner = %d, want this process, %d", pid, os.Getpid())
+	}
+
+	if _, err := AcquireLock(path); err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("second AcquireLock() = %v, want the daemon already running", err)
+	}
+
+	release()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("lock file after release: %v, want it removed", err)
+	}
+	release, err = AcquireLock(path)
+	if err != nil {
+		t.Fatalf("AcquireLock() after release = %v", err)
+	}
+	release()
+}
+
+func TestAcquireLockReplacesStaleLock(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"process gone", fmt.Sprintf("%d\n", 1<<30)},
+		{"not a pid", "courtside\n"},
+		{"empty", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), lockFileName)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			release, err := AcquireLock(path)
+			if err != nil {
+				t.Fatalf("AcquireLock() = %v, want the stale lock replaced", err)
+			}
+			defer release()
+			if pid, ok := lockOwner(path); !ok || pid != os.Getpid() {
+				t.Errorf("lock owner = %d, want this process, %d", pid, os.Getpid())
+			}
+		})
+	}
+}
@@ -1 +1,695 @@
 package data
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SettingsVersion is the settings.yaml schema version this build reads and
+// writes. Files without a version are from before versioning and read as 1.
+const SettingsVersion = 1
+
+// envPrefix starts the environment variable overriding each settings key:
+// refresh.live_list is COURTSIDE_REFRESH_LIVE_LIST.
+const envPrefix = "COURTSIDE_"
+
+// RefreshSettings configures how often data is refreshed. Zero values use
+// the defaults in DefaultSettings.
+type RefreshSettings struct {
+	LiveList  time.Duration `yaml:"live_list,omitempty"`  // live game list refresh
+	Game      time.Duration `yaml:"game,omitempty"`       // play-by-play poll of the open live game
+	Comments  time.Duration `yaml:"comments,omitempty"`   // game thread comments panel refresh
+	Daemon    time.Duration `yaml:"daemon,omitempty"`     // notify-daemon scoreboard poll
+	StatsDays int           `yaml:"stats_days,omitempty"` // days of finished games in the stats view
+}
+
+// NBASettings configures the NBA Stats API client. Zero values use the
+// defaults in DefaultSettings.
+type NBASettings struct {
+	Timeout         time.Duration    `yaml:"timeout,omitempty"`          // per HTTP request
+	RequestInterval time.Duration    `yaml:"request_interval,omitempty"` // least time between requests
+	Cache           NBACacheSettings `yaml:"cache,omitempty"`
+}
+
+// NBACacheSettings are how long API responses are cached, and how many.
+type NBACacheSettings struct {
+	Matches      time.Duration `yaml:"matches,omitempty"`       // scoreboards
+	MatchDetails time.Duration `yaml:"match_details,omitempty"` // live box scores
+	LiveMatches  time.Duration `yaml:"live_matches,omitempty"`  // live game list
+	Schedule     time.Duration `yaml:"schedule,omitempty"`      // season schedule
+	Team         time.Duration `yaml:"team,omitempty"`          // team pages
+	Player       time.Duration `yaml:"player,omitempty"`        // player profiles
+	Leaders      time.Duration `yaml:"leaders,omitempty"`       // league leaders
+	Preview      time.Duration `yaml:"preview,omitempty"`       // pre-game previews
+	MaxMatches   int           `yaml:"max_matches,omitempty"`   // scoreboards kept
+	MaxDetails   int           `yaml:"max_details,omitempty"`   // box scores kept
+}
+
+// DefaultSettings returns the settings used for every key settings.yaml and
+// the environment leave unset.
+func DefaultSettings() *Settings {
+	return &Settings{
+		Version: SettingsVersion,
+		Refresh: RefreshSettings{
+			LiveList:  30 * time.Second,
+			Game:      30 * time.Second, // NBA games update quickly
+			Comments:  45 * time.Second, // shares Reddit's 10 requests a minute with highlight searches
+			Daemon:    30 * time.Second, // matches the live view's poll rate
+			StatsDays: 5,
+		},
+		NBA: NBASettings{
+			Timeout:         15 * time.Second,
+			RequestInterval: 250 * time.Millisecond,
+			Cache: NBACacheSettings{
+				Matches:      30 * time.Second, // scoreboard updates frequently
+				MatchDetails: 10 * time.Second, // live box score data
+				LiveMatches:  10 * time.Second, // live game list
+				Schedule:     10 * time.Minute, // full season schedule (large payload)
+				Team:         10 * time.Minute,
+				Player:       10 * time.Minute,
+				Leaders:      10 * time.Minute,
+				Preview:      10 * time.Minute,
+				MaxMatches:   10,
+				MaxDetails:   50,
+			},
+		},
+		Notifications: NotificationSettings{
+			DefaultAction:   NotifyActionDesktop,
+			ReminderMinutes: 15,
+		},
+	}
+}
+
+// KeyError is a problem with the value of a settings key.
+type KeyError struct {
+	Key string // dotted key, "refresh.live_list"
+	Err error
+}
+
+func (e *KeyError) Error() string {
+	return e.Key + ": " + e.Err.Error()
+}
+
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// ErrUnknownKey is returned for a key settings.yaml has no such setting for.
+var ErrUnknownKey = errors.New("unknown key")
+
+// configKey is a single-valued settings key that can be read and set as text,
+// checked, and overridden from the environment.
+type configKey struct {
+	name  string
+	doc   string
+	get   func(s *Settings) string
+	set   func(s *Settings, value string) error
+	check func(s *Settings) error // nil when any value is valid
+
+	// secret keys, such as passwords, are listed without their value
+	secret bool
+
+	// fill sets the value from defaults when s leaves it unset; nil for keys
+	// whose unset value has its own meaning.
+	fill func(s, defaults *Settings)
+}
+
+// configKeys lists the keys `courtside config` knows, in settings.yaml order.
+var configKeys = []configKey{
+	listKey("favorite_teams", "favorite team tricodes",
+		func(s *Settings) *[]string { return &s.FavoriteTeams }, strings.ToUpper, checkTricode),
+	listKey("conferences", "conferences whose games are listed (East, West)",
+		func(s *Settings) *[]string { return &s.Conferences }, conferenceName, checkConference),
+	choiceKey("notifications.default_action", "action for events no rule matches",
+		func(s *Settings) *string { return &s.Notifications.DefaultAction },
+		NotifyActionDesktop, NotifyActionBell, NotifyActionLog, NotifyActionNone),
+	intKey("notifications.reminder_minutes", "minutes before tip-off reminders fire",
+		func(s *Settings) *int { return &s.Notifications.ReminderMinutes }, 1, 24*60),
+	boolKey("highlights.parallel", "ask every highlight source at once",
+		func(s *Settings) *bool { return &s.Highlights.Parallel }),
+	stringKey("reddit.client_id", "Reddit app client ID",
+		func(s *Settings) *string { return &s.Reddit.ClientID }),
+	secretKey("reddit.client_secret", "Reddit app secret",
+		func(s *Settings) *string { return &s.Reddit.ClientSecret }),
+	stringKey("reddit.username", "Reddit username of a script app",
+		func(s *Settings) *string { return &s.Reddit.Username }),
+	secretKey("reddit.password", "Reddit password of a script app",
+		func(s *Settings) *string { return &s.Reddit.Password }),
+	stringKey("reddit.user_agent", "User-Agent sent to Reddit",
+		func(s *Settings) *string { return &s.Reddit.UserAgent }),
+	durationKey("refresh.live_list", "live game list refresh",
+		func(s *Settings) *time.Duration { return &s.Refresh.LiveList }, 5*time.Second),
+	durationKey("refresh.game", "play-by-play poll of the open live game",
+		func(s *Settings) *time.Duration { return &s.Refresh.Game }, 5*time.Second),
+	durationKey("refresh.comments", "game thread comments refresh",
+		func(s *Settings) *time.Duration { return &s.Refresh.Comments }, 10*time.Second),
+	durationKey("refresh.daemon", "notify-daemon scoreboard poll",
+		func(s *Settings) *time.Duration { return &s.Refresh.Daemon }, 10*time.Second),
+	intKey("refresh.stats_days", "days of finished games in the stats view",
+		func(s *Settings) *int { return &s.Refresh.StatsDays }, 1, 14),
+	durationKey("nba.timeout", "NBA Stats API request timeout",
+		func(s *Settings) *time.Duration { return &s.NBA.Timeout }, time.Second),
+	durationKey("nba.request_interval", "least time between NBA Stats API requests",
+		func(s *Settings) *time.Duration { return &s.NBA.RequestInterval }, 50*time.Millisecond),
+	durationKey("nba.cache.matches", "scoreboard cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.Matches }, time.Second),
+	durationKey("nba.cache.match_details", "live box score cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.MatchDetails }, time.Second),
+	durationKey("nba.cache.live_matches", "live game list cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.LiveMatches }, time.Second),
+	durationKey("nba.cache.schedule", "season schedule cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.Schedule }, time.Second),
+	durationKey("nba.cache.team", "team page cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.Team }, time.Second),
+	durationKey("nba.cache.player", "player profile cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.Player }, time.Second),
+	durationKey("nba.cache.leaders", "league leaders cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.Leaders }, time.Second),
+	durationKey("nba.cache.preview", "game preview cache lifetime",
+		func(s *Settings) *time.Duration { return &s.NBA.Cache.Preview }, time.Second),
+	intKey("nba.cache.max_matches", "scoreboards kept in the cache",
+		func(s *Settings) *int { return &s.NBA.Cache.MaxMatches }, 1, 1000),
+	intKey("nba.cache.max_details", "box scores kept in the cache",
+		func(s *Settings) *int { return &s.NBA.Cache.MaxDetails }, 1, 1000),
+}
+
+// ConfigKey describes a settings key for `courtside config`.
+type ConfigKey struct {
+	Name string // dotted key, "refresh.live_list"
+	Doc  string
+	Env  string // environment variable overriding it
+
+	// Secret is set for keys such as passwords, whose value is printed only
+	// when asked for by name.
+	Secret bool
+}
+
+// ConfigKeys returns the keys that can be read, set and overridden from the
+// environment, in settings.yaml order. Lists such as notification rules and
+// highlight sources are edited in the file only.
+func ConfigKeys() []ConfigKey {
+	keys := make([]ConfigKey, 0, len(configKeys))
+	for _, k := range configKeys {
+		keys = append(keys, ConfigKey{Name: k.name, Doc: k.doc, Env: EnvVar(k.name), Secret: k.secret})
+	}
+	return keys
+}
+
+// EnvVar returns the environment variable overriding key.
+func EnvVar(key string) string {
+	return envPrefix + strings.ToUpper(strings.NewReplacer(".", "_").Replace(key))
+}
+
+func lookupKey(name string) (*configKey, error) {
+	for i := range configKeys {
+		if configKeys[i].name == name {
+			return &configKeys[i], nil
+		}
+	}
+	return nil, &KeyError{Key: name, Err: ErrUnknownKey}
+}
+
+// Get returns the value of key as text; lists are comma-separated.
+func (s *Settings) Get(key string) (string, error) {
+	k, err := lookupKey(key)
+	if err != nil {
+		return "", err
+	}
+	return k.get(s), nil
+}
+
+// Set parses value and sets key to it. An empty value unsets the key.
+func (s *Settings) Set(key, value string) error {
+	k, err := lookupKey(key)
+	if err != nil {
+		return err
+	}
+	if err := k.set(s, strings.TrimSpace(value)); err != nil {
+		return &KeyError{Key: key, Err: err}
+	}
+	if k.check != nil {
+		if err := k.check(s); err != nil {
+			return &KeyError{Key: key, Err: err}
+		}
+	}
+	return nil
+}
+
+// Effective returns a copy of s with each key set in the environment
+// overridden and the tunables s leaves unset filled from DefaultSettings.
+// Environment values that don't parse are skipped and reported in the
+// error, which names their variables; the settings returned are usable
+// either way.
+func (s *Settings) Effective() (*Settings, error) {
+	effective := &Settings{}
+	if s != nil {
+		*effective = *s
+	}
+
+	var errs []error
+	for _, k := range configKeys {
+		env := EnvVar(k.name)
+		value, ok := os.LookupEnv(env)
+		if !ok || value == "" {
+			continue
+		}
+		previous := *effective
+		if err := k.set(effective, strings.TrimSpace(value)); err != nil {
+			errs = append(errs, &KeyError{Key: env, Err: err})
+			continue
+		}
+		if k.check != nil {
+			if err := k.check(effective); err != nil {
+				*effective = previous
+				errs = append(errs, &KeyError{Key: env, Err: err})
+			}
+		}
+	}
+
+	defaults := DefaultSettings()
+	for _, k := range configKeys {
+		if k.fill != nil {
+			k.fill(effective, defaults)
+		}
+	}
+	return effective, errors.Join(errs...)
+}
+
+// Validate checks the schema version and every key's value, returning one
+// error per bad key.
+func (s *Settings) Validate() error {
+	var errs []error
+	if s.Version < 0 || s.Version > SettingsVersion {
+		errs = append(errs, &KeyError{Key: "version", Err: fmt.Errorf(
+			"%d is not supported, this build reads up to version %d", s.Version, SettingsVersion)})
+	}
+	for _, k := range configKeys {
+		if k.check == nil {
+			continue
+		}
+		if err := k.check(s); err != nil {
+			errs = append(errs, &KeyError{Key: k.name, Err: err})
+		}
+	}
+	errs = append(errs, s.validateLists()...)
+	return errors.Join(errs...)
+}
+
+// validateLists checks the entries of the lists edited in the file only:
+// notification rules, quiet hours, sinks and highlight sources. Errors are
+// named by entry, "notifications.rules[2].action".
+func (s *Settings) validateLists() []error {
+	var errs []error
+	check := func(key string, err error) {
+		if err != nil {
+			errs = append(errs, &KeyError{Key: key, Err: err})
+		}
+	}
+
+	for i, rule := range s.Notifications.Rules {
+		key := fmt.Sprintf("notifications.rules[%d]", i)
+		check(key+".action", checkChoice(rule.Action,
+			NotifyActionDesktop, NotifyActionBell, NotifyActionLog, NotifyActionNone))
+		for j, event := range rule.Events {
+			check(fmt.Sprintf("%s.events[%d]", key, j), checkEvent(event))
+		}
+		if rule.MaxClock != "" {
+			check(key+".max_clock", checkGameClock(rule.MaxClock))
+		}
+	}
+
+	if q := s.Notifications.QuietHours; q != nil {
+		check("notifications.quiet_hours.start", checkTimeOfDay(q.Start))
+		check("notifications.quiet_hours.end", checkTimeOfDay(q.End))
+	}
+
+	for i, sink := range s.Notifications.Sinks {
+		key := fmt.Sprintf("notifications.sinks[%d]", i)
+		check(key+".type", checkChoice(sink.Type, SinkTypeWebhook, SinkTypeExec))
+		switch {
+		case sink.Type == SinkTypeWebhook:
+			check(key+".url", checkURL(sink.URL))
+		case sink.Type == SinkTypeExec && len(sink.Command) == 0:
+			check(key+".command", errors.New("missing, exec sinks need a command to run"))
+		}
+		for j, event := range sink.Events {
+			check(fmt.Sprintf("%s.events[%d]", key, j), checkEvent(event))
+		}
+		if sink.Timeout != "" {
+			if d, err := time.ParseDuration(sink.Timeout); err != nil || d <= 0 {
+				check(key+".timeout", fmt.Errorf("invalid duration %q, use a value like \"10s\"", sink.Timeout))
+			}
+		}
+		if sink.Retries < 0 {
+			check(key+".retries", fmt.Errorf("must be at least 0, got %d", sink.Retries))
+		}
+	}
+
+	for i, source := range s.Highlights.Sources {
+		key := fmt.Sprintf("highlights.sources[%d]", i)
+		check(key+".type", checkChoice(source.Type,
+			HighlightSourceReddit, HighlightSourceFeed, HighlightSourceFile))
+		switch {
+		case source.Type == HighlightSourceFeed:
+			check(key+".url", checkURL(source.URL))
+		case source.Type == HighlightSourceFile && source.Path == "":
+			check(key+".path", errors.New("missing, file sources need the path of a clip file"))
+		}
+	}
+	return errs
+}
+
+// CheckSettings reports every problem in the content of a settings file:
+// YAML syntax, unknown keys, values of the wrong type and values Validate
+// rejects. Each error names its key.
+func CheckSettings(content []byte) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return err
+	}
+	if len(root.Content) == 0 {
+		return nil // empty file: all defaults
+	}
+
+	var errs []error
+	checkNode(root.Content[0], reflect.TypeFor[Settings](), "", &errs)
+
+	// Values of the wrong type are reported above by key; the rest decode
+	var settings Settings
+	if err := root.Decode(&settings); err != nil && len(errs) == 0 {
+		return err
+	}
+	errs = append(errs, settings.Validate())
+	return errors.Join(errs...)
+}
+
+// checkNode reports the keys of node that t has no field for and the values
+// that don't decode into their field's type, naming each by its dotted path.
+func checkNode(node *yaml.Node, t reflect.Type, path string, errs *[]error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	switch {
+	case t.Kind() == reflect.Struct && t != reflect.TypeFor[time.Duration]():
+		if node.Kind != yaml.MappingNode {
+			*errs = append(*errs, &KeyError{Key: keyName(path), Err: errors.New("must be a mapping of keys")})
+			return
+		}
+		fields := yamlFields(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			name := node.Content[i].Value
+			key := joinKey(path, name)
+			field, ok := fields[name]
+			if !ok {
+				*errs = append(*errs, &KeyError{Key: key, Err: fmt.Errorf("%w (line %d)", ErrUnknownKey, node.Content[i].Line)})
+				continue
+			}
+			checkNode(node.Content[i+1], field, key, errs)
+		}
+	case t.Kind() == reflect.Slice && node.Kind == yaml.SequenceNode:
+		for i, item := range node.Content {
+			checkNode(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	default:
+		if err := node.Decode(reflect.New(t).Interface()); err != nil {
+			err = fmt.Errorf("invalid value %q (line %d)", node.Value, node.Line)
+			if t == reflect.TypeFor[time.Duration]() {
+				err = fmt.Errorf("invalid duration %q (line %d), use a value like \"30s\" or \"10m\"", node.Value, node.Line)
+			}
+			*errs = append(*errs, &KeyError{Key: keyName(path), Err: err})
+		}
+	}
+}
+
+// yamlFields maps the YAML names of t's fields to their types.
+func yamlFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+func joinKey(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func keyName(path string) string {
+	if path == "" {
+		return "settings"
+	}
+	return path
+}
+
+func durationKey(name, doc string, field func(*Settings) *time.Duration, least time.Duration) configKey {
+	return configKey{
+		name: name,
+		doc:  doc,
+		get: func(s *Settings) string {
+			if *field(s) == 0 {
+				return ""
+			}
+			return field(s).String()
+		},
+		set: func(s *Settings, value string) error {
+			if value == "" {
+				*field(s) = 0
+				return nil
+			}
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid duration %q, use a value like \"30s\" or \"10m\"", value)
+			}
+			*field(s) = d
+			return nil
+		},
+		check: func(s *Settings) error {
+			if d := *field(s); d != 0 && d < least {
+				return fmt.Errorf("must be at least %s, got %s", least, d)
+			}
+			return nil
+		},
+		fill: func(s, defaults *Settings) {
+			if *field(s) == 0 {
+				*field(s) = *field(defaults)
+			}
+		},
+	}
+}
+
+func intKey(name, doc string, field func(*Settings) *int, least, most int) configKey {
+	return configKey{
+		name: name,
+		doc:  doc,
+		get: func(s *Settings) string {
+			if *field(s) == 0 {
+				return ""
+			}
+			return strconv.Itoa(*field(s))
+		},
+		set: func(s *Settings, value string) error {
+			if value == "" {
+				*field(s) = 0
+				return nil
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid number %q", value)
+			}
+			*field(s) = n
+			return nil
+		},
+		check: func(s *Settings) error {
+			if n := *field(s); n != 0 && (n < least || n > most) {
+				return fmt.Errorf("must be between %d and %d, got %d", least, most, n)
+			}
+			return nil
+		},
+		fill: func(s, defaults *Settings) {
+			if *field(s) == 0 {
+				*field(s) = *field(defaults)
+			}
+		},
+	}
+}
+
+func boolKey(name, doc string, field func(*Settings) *bool) configKey {
+	return configKey{
+		name: name,
+		doc:  doc,
+		get:  func(s *Settings) string { return strconv.FormatBool(*field(s)) },
+		set: func(s *Settings, value string) error {
+			if value == "" {
+				*field(s) = false
+				return nil
+			}
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid value %q, use true or false", value)
+			}
+			*field(s) = b
+			return nil
+		},
+	}
+}
+
+func stringKey(name, doc string, field func(*Settings) *string) configKey {
+	return configKey{
+		name: name,
+		doc:  doc,
+		get:  func(s *Settings) string { return *field(s) },
+		set: func(s *Settings, value string) error {
+			*field(s) = value
+			return nil
+		},
+	}
+}
+
+func secretKey(name, doc string, field func(*Settings) *string) configKey {
+	k := stringKey(name, doc, field)
+	k.secret = true
+	return k
+}
+
+func choiceKey(name, doc string, field func(*Settings) *string, choices ...string) configKey {
+	k := stringKey(name, doc, field)
+	k.set = func(s *Settings, value string) error {
+		*field(s) = strings.ToLower(value)
+		return nil
+	}
+	k.check = func(s *Settings) error {
+		if v := *field(s); v != "" && !slices.Contains(choices, v) {
+			return fmt.Errorf("invalid value %q, use one of %s", v, strings.Join(choices, ", "))
+		}
+		return nil
+	}
+	k.fill = func(s, defaults *Settings) {
+		if *field(s) == "" {
+			*field(s) = *field(defaults)
+		}
+	}
+	return k
+}
+
+func listKey(name, doc string, field func(*Settings) *[]string, normalize func(string) string, checkItem func(string) error) configKey {
+	return configKey{
+		name: name,
+		doc:  doc,
+		get:  func(s *Settings) string { return strings.Join(*field(s), ",") },
+		set: func(s *Settings, value string) error {
+			var items []string
+			for item := range strings.SplitSeq(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, normalize(item))
+				}
+			}
+			*field(s) = items
+			return nil
+		},
+		check: func(s *Settings) error {
+			for _, item := range *field(s) {
+				if err := checkItem(item); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// checkTricode accepts team tricodes: two to four capital letters or digits.
+// Teams outside the NBA may be favorites too, so any such code is allowed.
+func checkTricode(tricode string) error {
+	if len(tricode) < 2 || len(tricode) > 4 || strings.ToUpper(tricode) != tricode {
+		return fmt.Errorf("invalid team %q, use a tricode like BOS", tricode)
+	}
+	return nil
+}
+
+// conferenceName capitalizes a conference as settings store it: "east" is "East".
+func conferenceName(conference string) string {
+	if conference == "" {
+		return conference
+	}
+	return strings.ToUpper(conference[:1]) + strings.ToLower(conference[1:])
+}
+
+func checkConference(conference string) error {
+	if !slices.Contains(GetAllConferences(), conference) {
+		return fmt.Errorf("invalid conference %q, use %s", conference, strings.Join(GetAllConferences(), " or "))
+	}
+	return nil
+}
+
+// checkChoice checks a required value that must be one of choices.
+func checkChoice(value string, choices ...string) error {
+	if value == "" {
+		return fmt.Errorf("missing, use one of %s", strings.Join(choices, ", "))
+	}
+	if !slices.Contains(choices, value) {
+		return fmt.Errorf("invalid value %q, use one of %s", value, strings.Join(choices, ", "))
+	}
+	return nil
+}
+
+func checkEvent(event string) error {
+	if !slices.Contains(NotifyEvents, event) {
+		return fmt.Errorf("invalid event %q, use one of %s", event, strings.Join(NotifyEvents, ", "))
+	}
+	return nil
+}
+
+// checkGameClock checks time left in a period, "5:00" or "0:30".
+func checkGameClock(clock string) error {
+	var minutes, seconds int
+	if n, err := fmt.Sscanf(clock, "%d:%d", &minutes, &seconds); err != nil || n != 2 ||
+		minutes < 0 || minutes > 12 || seconds < 0 || seconds > 59 {
+		return fmt.Errorf("invalid clock %q, use minutes and seconds like \"5:00\"", clock)
+	}
+	return nil
+}
+
+// checkTimeOfDay checks a required 24-hour time, "23:00".
+func checkTimeOfDay(value string) error {
+	if _, err := time.Parse("15:04", strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("invalid time %q, use a 24-hour time like \"23:00\"", value)
+	}
+	return nil
+}
+
+// checkURL checks a required http or https URL.
+func checkURL(value string) error {
+	if value == "" {
+		return errors.New("missing, use an http or https URL")
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		// Not the URL itself: webhook URLs often embed a secret token
+		return errors.New("invalid URL, use an http or https URL")
+	}
+	return nil
+}
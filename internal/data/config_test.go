@@ -0,0 +1,169 @@
+package data
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckSettingsNamesKeys(t *testing.T) {
+	content := []byte(`
+version: 1
+conferences: [North]
+refresh:
+  live_list: 30
+  game: 1s
+  bogus: 3
+notifications:
+  rules:
+    - action: log
+      colour: red
+`)
+	err := CheckSettings(content)
+	if err == nil {
+		t.Fatal("CheckSettings() = nil, want errors")
+	}
+	for _, key := range []string{"conferences:", "refresh.live_list:", "refresh.game:", "refresh.bogus:", "notifications.rules[0].colour:"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("CheckSettings() error %q does not name %s", err, key)
+		}
+	}
+	if !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("CheckSettings() error does not wrap ErrUnknownKey")
+	}
+
+	if err := CheckSettings([]byte("refresh:\n  live_list: 15s\nversion: 1\n")); err != nil {
+		t.Errorf("CheckSettings(valid) = %v", err)
+	}
+}
+
+func TestCheckSettingsVersion(t *testing.T) {
+	err := CheckSettings([]byte("version: 2\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "version:") {
+		t.Errorf("CheckSettings(newer version) = %v, want a version error", err)
+	}
+}
+
+func TestEffective(t *testing.T) {
+	t.Setenv("COURTSIDE_REFRESH_GAME", "10s")
+	t.Setenv("COURTSIDE_REFRESH_COMMENTS", "soon")
+
+	s := &Settings{Refresh: RefreshSettings{LiveList: 20 * time.Second}}
+	effective, err := s.Effective()
+	if err == nil || !strings.Contains(err.Error(), "COURTSIDE_REFRESH_COMMENTS") {
+		t.Errorf("Effective() error = %v, want it to name COURTSIDE_REFRESH_COMMENTS", err)
+	}
+
+	defaults := DefaultSettings()
+	if effective.Refresh.LiveList != 20*time.Second {
+		t.Errorf("LiveList = %s, want the file's 20s", effective.Refresh.LiveList)
+	}
+	if effective.Refresh.Game != 10*time.Second {
+		t.Errorf("Game = %s, want the environment's 10s", effective.Refresh.Game)
+	}
+	if effective.Refresh.Comments != defaults.Refresh.Comments {
+		t.Errorf("Comments = %s, want the default %s", effective.Refresh.Comments, defaults.Refresh.Comments)
+	}
+	if effective.NBA.Cache.MaxDetails != defaults.NBA.Cache.MaxDetails {
+		t.Errorf("MaxDetails = %d, want the default %d", effective.NBA.Cache.MaxDetails, defaults.NBA.Cache.MaxDetails)
+	}
+	if s.Refresh.Game != 0 {
+		t.Errorf("Effective() changed the settings it was called on")
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := &Settings{}
+	if err := s.Set("favorite_teams", "bos, nyk"); err != nil {
+		t.Fatalf("Set(favorite_teams) = %v", err)
+	}
+	if got, _ := s.Get("favorite_teams"); got != "BOS,NYK" {
+		t.Errorf("favorite_teams = %q, want BOS,NYK", got)
+	}
+	if err := s.Set("nba.cache.max_matches", "0"); err != nil {
+		t.Errorf("Set(max_matches, 0) = %v, want unset", err)
+	}
+	if err := s.Set("refresh.stats_days", "30"); err == nil {
+		t.Error("Set(stats_days, 30) = nil, want a range error")
+	}
+	if err := s.Set("refresh.nope", "1"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Set(unknown key) = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestSaveSettingsMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	path, err := SettingsPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("version: 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SaveSettings(&Settings{Reddit: RedditSettings{Password: "hunter2"}}); err != nil {
+		t.Fatalf("SaveSettings() = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("settings.yaml mode = %o, want 600", mode)
+	}
+}
+
+func TestValidateLists(t *testing.T) {
+	err := CheckSettings([]byte(`
+notifications:
+  rules:
+    - action: desktop
+      events: [close_game]
+    - action: popup
+      events: [overtime, buzzer]
+      max_clock: "5 minutes"
+  quiet_hours:
+    start: "23:00"
+    end: "7am"
+  sinks:
+    - type: webhook
+      url: hooks.example.com/abc
+      timeout: soon
+    - type: exec
+highlights:
+  sources:
+    - type: feed
+    - type: file
+    - type: youtube
+`))
+	if err == nil {
+		t.Fatal("CheckSettings() = nil, want errors")
+	}
+	for _, key := range []string{
+		"notifications.rules[1].action:",
+		"notifications.rules[1].events[1]:",
+		"notifications.rules[1].max_clock:",
+		"notifications.quiet_hours.end:",
+		"notifications.sinks[0].url:",
+		"notifications.sinks[0].timeout:",
+		"notifications.sinks[1].command:",
+		"highlights.sources[0].url:",
+		"highlights.sources[1].path:",
+		"highlights.sources[2].type:",
+	} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("CheckSettings() error %q does not name %s", err, key)
+		}
+	}
+	for _, key := range []string{"rules[0]", "quiet_hours.start", "abc"} {
+		if strings.Contains(err.Error(), key) {
+			t.Errorf("CheckSettings() error %q mentions %s", err, key)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// lastSeenFileName holds how far each game was followed, in the state directory.
+const lastSeenFileName = "last_seen.json"
+
+// lastSeenMaxAge is how long a game's last seen position is kept.
+const lastSeenMaxAge = 3 * 24 * time.Hour
+
+// LastSeen is the last play of a game shown in the live view, with the score then.
+type LastSeen struct {
+	EventID   int       `json:"event_id"`
+	HomeScore int       `json:"home_score"`
+	AwayScore int       `json:"away_score"`
+	Time      time.Time `json:"time"`
+}
+
+// LoadLastSeen returns the last seen position of a game, if there is one.
+func LoadLastSeen(matchID int) (LastSeen, bool) {
+	all, err := loadLastSeen()
+	if err != nil {
+		return LastSeen{}, false
+	}
+	seen, ok := all[strconv.Itoa(matchID)]
+	return seen, ok
+}
+
+// SaveLastSeen records the last seen position of a game, dropping games
+// not seen for a few days.
+func SaveLastSeen(matchID int, seen LastSeen) error {
+	all, err := loadLastSeen()
+	if err != nil {
+		return err
+	}
+	for id, s := range all {
+		if time.Since(s.Time) > lastSeenMaxAge {
+			delete(all, id)
+		}
+	}
+	all[strconv.Itoa(matchID)] = seen
+
+	path, err := lastSeenPath()
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(all)
+	if err != nil {
+		return fmt.Errorf("marshal last seen: %w", err)
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// loadLastSeen reads every game's last seen position, keyed by match ID.
+// A missing or unreadable file is empty.
+func loadLastSeen() (map[string]LastSeen, error) {
+	path, err := lastSeenPath()
+	if err != nil {
+		return nil, err
+	}
+	all := make(map[string]LastSeen)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return all, nil
+	}
+	if err := json.Unmarshal(b, &all); err != nil {
+		return make(map[string]LastSeen), nil
+	}
+	return all, nil
+}
+
+// lastSeenPath returns the path of the last seen file.
+func lastSeenPath() (string, error) {
+	dir, err := StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, lastSeenFileName), nil
+}
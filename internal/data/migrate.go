@@ -0,0 +1,93 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// legacyAppName names the directories courtside used to share with Golazo,
+// the football app it was forked from.
+const legacyAppName = "golazo"
+
+// migratedFileName marks, in the state directory, that the Golazo
+// directories were looked for, so the notice is shown once.
+const migratedFileName = "migrated-from-golazo"
+
+// Migration describes the first run after courtside moved out of the
+// directories it used to share with Golazo.
+type Migration struct {
+	LegacyDirs []string // Golazo directories found, left as they are
+}
+
+// MigrateFromGolazo looks, once, for the config and cache directories
+// courtside used to share with Golazo. Nothing in them is carried over:
+// before the move, courtside's settings.yaml held only the football league
+// selection, and its other files there (live updates, goal links, the
+// version check, the notification icon) are Golazo's or caches that fill
+// again. They are left for Golazo. It returns the directories found, or nil
+// when there are none or the check already ran.
+func MigrateFromGolazo() (*Migration, error) {
+	stateDir, err := StateDir()
+	if err != nil {
+		return nil, err
+	}
+	marker := filepath.Join(stateDir, migratedFileName)
+	if _, err := os.Stat(marker); err == nil {
+		return nil, nil
+	}
+
+	var migration Migration
+	var errs []error
+	for _, legacyDir := range []func() (string, error){legacyConfigDir, legacyCacheDir} {
+		dir, err := legacyDir()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			migration.LegacyDirs = append(migration.LegacyDirs, dir)
+		}
+	}
+
+	if err := os.WriteFile(marker, nil, 0644); err != nil {
+		errs = append(errs, fmt.Errorf("mark migration: %w", err))
+	}
+	err = errors.Join(errs...)
+	if len(migration.LegacyDirs) == 0 {
+		return nil, err
+	}
+	return &migration, err
+}
+
+// legacyConfigDir returns the Golazo config directory courtside used to
+// share: ~/.config/golazo (or $XDG_CONFIG_HOME/golazo) on Linux and
+// ~/.golazo elsewhere.
+func legacyConfigDir() (string, error) {
+	if runtime.GOOS == "linux" {
+		if xdgConfig := os.Getenv("XDG_CONFIG_HOME"); xdgConfig != "" {
+			return filepath.Join(xdgConfig, legacyAppName), nil
+		}
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("get home directory: %w", err)
+		}
+		return filepath.Join(homeDir, ".config", legacyAppName), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, "."+legacyAppName), nil
+}
+
+// legacyCacheDir returns the Golazo cache directory courtside used to share.
+func legacyCacheDir() (string, error) {
+	userCache, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("get user cache directory: %w", err)
+	}
+	return filepath.Join(userCache, legacyAppName), nil
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestMigrateFromGolazo(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the Golazo directories follow XDG on Linux only")
+	}
+	root := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(root, "config"))
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(root, "cache"))
+	t.Setenv("XDG_STATE_HOME", filepath.Join(root, "state"))
+
+	write := func(path, content string) {
+		t.Helper()
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// What courtside wrote to the Golazo directories before the move
+	legacy := map[string]string{
+		"config/golazo/settings.yaml":      "selected_leagues:\n    - 47\n    - 87\n",
+		"config/golazo/matches.json":       "[]",
+		"config/golazo/updates_1.json":     "[]",
+		"config/golazo/goal_links.json":    "{}",
+		"config/golazo/latest_version.txt": "v0.1.0",
+		"cache/golazo/icon.png":            "png",
+	}
+	for path, content := range legacy {
+		write(path, content)
+	}
+
+	migration, err := MigrateFromGolazo()
+	if err != nil {
+		t.Fatalf("MigrateFromGolazo() error = %v", err)
+	}
+	want := []string{filepath.Join(root, "config/golazo"), filepath.Join(root, "cache/golazo")}
+	if migration == nil || !slices.Equal(migration.LegacyDirs, want) {
+		t.Fatalf("MigrateFromGolazo() = %+v, want the Golazo directories %v", migration, want)
+	}
+
+	// Nothing is carried over, and Golazo's files stay as they were
+	for path, content := range legacy {
+		if got, err := os.ReadFile(filepath.Join(root, path)); err != nil || string(got) != content {
+			t.Errorf("%s = %q, %v, want it left as %q", path, got, err, content)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "config/courtside", settingsFileName)); !os.IsNotExist(err) {
+		t.Errorf("courtside settings.yaml written from Golazo's: %v", err)
+	}
+
+	if migration, err := MigrateFromGolazo(); migration != nil || err != nil {
+		t.Errorf("second MigrateFromGolazo() = %+v, %v, want nothing", migration, err)
+	}
+}
+
+func TestMigrateFromGolazoWithoutGolazo(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(root, "config"))
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(root, "cache"))
+	t.Setenv("XDG_STATE_HOME", filepath.Join(root, "state"))
+	t.Setenv("HOME", root)
+
+	if migration, err := MigrateFromGolazo(); migration != nil || err != nil {
+		t.Errorf("MigrateFromGolazo() = %+v, %v, want nothing to report", migration, err)
+	}
+}
@@ -51,6 +51,21 @@ func buildNBADetails(m api.Match) *api.MatchDetails {
 			{ID: 9, DisplayMinute: "Q3 7:34", Type: "foul", Team: m.AwayTeam, Player: strp("B. Adebayo")},
 			{ID: 10, DisplayMinute: "Q3 4:52", Type: "field_goal", Team: m.HomeTeam, Player: strp("J. Brown"), IsThree: &three, Points: &pts3},
 		}
+		// Box score so far, with players close to milestones for the watch list
+		d.HomePlayerStats = nbaMockPlayers(m.HomeTeam, []playerSeed{
+			{"J. Tatum", "F", "27:40", 29, 9, 5, 10, 19, 4, 5, 6, 9},
+			{"J. Brown", "G", "26:12", 22, 4, 3, 8, 15, 3, 3, 4, 7},
+			{"D. White", "G", "24:30", 14, 3, 6, 5, 10, 4, 0, 0, 5},
+			{"J. Holiday", "G", "25:05", 14, 5, 4, 6, 11, 2, 0, 0, 6},
+			{"A. Horford", "C", "22:48", 8, 7, 2, 3, 6, 2, 0, 0, 3},
+		})
+		d.AwayPlayerStats = nbaMockPlayers(m.AwayTeam, []playerSeed{
+			{"T. Herro", "G", "27:10", 27, 3, 4, 9, 16, 9, 0, 0, -2},
+			{"J. Butler", "F", "26:00", 19, 6, 9, 6, 12, 0, 7, 8, -6},
+			{"B. Adebayo", "C", "25:30", 16, 12, 3, 7, 11, 0, 2, 2, -9},
+			{"D. Robinson", "F", "18:44", 9, 2, 1, 3, 7, 3, 0, 0, -4},
+			{"K. Love", "F", "14:20", 8, 5, 1, 3, 5, 2, 0, 0, -1},
+		})
 
 	case 9002: // LAL 51 - GSW 58  (Q2 live)
 		q := 2
@@ -238,8 +253,9 @@ type playerSeed struct {
 // assigned to the given team.
 func nbaMockPlayers(team api.Team, seeds []playerSeed) []api.PlayerStatLine {
 	lines := make([]api.PlayerStatLine, 0, len(seeds))
-	for _, s := range seeds {
+	for i, s := range seeds {
 		lines = append(lines, api.PlayerStatLine{
+			PlayerID:  team.ID*100 + i + 1, // stable per roster slot; see MockNBAPlayerProfile
 			Name:      s.name,
 			Position:  s.pos,
 			Minutes:   s.min,
@@ -253,7 +269,6 @@ func nbaMockPlayers(team api.Team, seeds []playerSeed) []api.PlayerStatLine {
 			FTA:       s.fta,
 			PlusMinus: s.pm,
 		})
-		_ = team // team association is implicit via HomePlayerStats / AwayPlayerStats
 	}
 	return lines
 }
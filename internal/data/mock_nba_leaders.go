@@ -0,0 +1,101 @@
+package data
+
+import (
+	"sort"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// leaderSeed holds per-game averages for a mock leaders row.
+type leaderSeed struct {
+	name                 string
+	team                 api.Team
+	gp                   int
+	min, pts, reb, ast   float64
+	stl, blk             float64
+	fgm, fga, fg3m, fg3a float64
+	ftm, fta             float64
+}
+
+// mockLeaderSeeds mixes players whose teams play in today's mock games with
+// players whose teams are idle, so both highlight states are visible.
+var mockLeaderSeeds = []leaderSeed{
+	{"Shai Gilgeous-Alexander", nbaTeam(teamOKC, "Oklahoma City Thunder", "OKC"), 58, 34.1, 31.8, 5.4, 6.2, 2.0, 0.9, 11.2, 21.4, 1.9, 5.2, 7.5, 8.4},
+	{"Luka Doncic", nbaTeam(teamLAL, "Los Angeles Lakers", "LAL"), 52, 36.0, 30.4, 8.6, 8.1, 1.6, 0.4, 10.1, 22.3, 3.8, 10.2, 6.4, 8.3},
+	{"Giannis Antetokounmpo", nbaTeam(teamMIL, "Milwaukee Bucks", "MIL"), 55, 34.8, 29.9, 11.6, 6.0, 0.9, 1.2, 11.8, 19.6, 0.2, 0.9, 6.1, 9.8},
+	{"Nikola Jokic", nbaTeam(teamDEN, "Denver Nuggets", "DEN"), 57, 36.2, 28.6, 12.8, 10.3, 1.7, 0.7, 10.9, 18.1, 2.0, 4.6, 4.8, 5.8},
+	{"Anthony Edwards", nbaTeam(teamMIN, "Minnesota Timberwolves", "MIN"), 59, 36.4, 27.3, 5.8, 4.5, 1.2, 0.6, 9.4, 20.6, 4.2, 10.4, 4.3, 5.2},
+	{"Jayson Tatum", nbaTeam(teamBOS, "Boston Celtics", "BOS"), 56, 36.5, 26.9, 8.7, 5.8, 1.1, 0.5, 9.1, 20.4, 3.5, 9.6, 5.2, 6.1},
+	{"Donovan Mitchell", nbaTeam(teamCLE, "Cleveland Cavaliers", "CLE"), 57, 31.2, 24.6, 4.4, 5.1, 1.3, 0.3, 8.5, 19.0, 3.4, 9.1, 4.2, 5.0},
+	{"Devin Booker", nbaTeam(teamPHX, "Phoenix Suns", "PHX"), 54, 35.7, 25.8, 4.2, 7.0, 0.9, 0.2, 8.6, 18.9, 2.3, 6.9, 6.3, 7.1},
+	{"Stephen Curry", nbaTeam(teamGSW, "Golden State Warriors", "GSW"), 51, 32.4, 24.2, 4.5, 6.1, 1.1, 0.4, 8.2, 17.6, 4.6, 11.1, 3.2, 3.5},
+	{"Jalen Brunson", nbaTeam(teamNYK, "New York Knicks", "NYK"), 55, 35.2, 26.1, 3.0, 7.4, 0.9, 0.2, 9.3, 19.2, 2.4, 6.4, 5.1, 6.0},
+	{"Victor Wembanyama", nbaTeam(teamSAS, "San Antonio Spurs", "SAS"), 49, 32.6, 24.3, 11.0, 3.8, 1.1, 3.8, 8.7, 18.3, 3.2, 9.0, 3.7, 4.5},
+	{"Domantas Sabonis", nbaTeam(teamSAC, "Sacramento Kings", "SAC"), 56, 35.4, 19.2, 13.9, 6.1, 0.8, 0.4, 7.8, 12.8, 0.6, 1.4, 3.0, 4.1},
+	{"Tyrese Haliburton", nbaTeam(teamIND, "Indiana Pacers", "IND"), 53, 33.8, 18.6, 3.6, 9.2, 1.4, 0.6, 6.5, 13.9, 3.0, 7.6, 2.6, 3.0},
+	{"Trae Young", nbaTeam(teamATL, "Atlanta Hawks", "ATL"), 57, 35.9, 24.2, 3.2, 11.4, 1.2, 0.2, 7.7, 18.4, 2.6, 7.4, 6.2, 7.0},
+	{"Evan Mobley", nbaTeam(teamCLE, "Cleveland Cavaliers", "CLE"), 58, 30.9, 18.4, 9.1, 3.0, 0.9, 1.7, 7.1, 12.4, 1.0, 2.7, 3.2, 4.5},
+	{"Jarrett Allen", nbaTeam(teamCLE, "Cleveland Cavaliers", "CLE"), 55, 28.1, 13.2, 10.2, 1.9, 0.9, 1.0, 5.6, 8.3, 0.0, 0.0, 2.0, 2.9},
+	{"Joel Embiid", nbaTeam(teamPHI, "Philadelphia 76ers", "PHI"), 31, 30.2, 23.9, 8.1, 4.3, 0.7, 1.6, 7.6, 16.4, 1.2, 3.8, 7.5, 8.6},
+	{"Tyrese Maxey", nbaTeam(teamPHI, "Philadelphia 76ers", "PHI"), 54, 37.8, 27.1, 3.4, 6.3, 1.9, 0.5, 9.2, 21.2, 3.4, 9.7, 5.3, 6.0},
+	{"Jaren Jackson Jr.", nbaTeam(teamMEM, "Memphis Grizzlies", "MEM"), 55, 29.7, 21.9, 5.9, 2.0, 1.2, 1.9, 7.7, 15.9, 2.1, 5.8, 4.4, 5.6},
+	{"Dyson Daniels", nbaTeam(teamATL, "Atlanta Hawks", "ATL"), 57, 33.8, 13.9, 5.8, 4.1, 3.0, 0.6, 5.7, 12.6, 1.0, 3.4, 1.5, 2.2},
+}
+
+// MockNBALeagueLeaders returns a league leaders table built from the mock seeds.
+// category is a leagueleaders StatCategory ("PTS", "FG3_PCT", ...) and mode is
+// "PerGame", "Totals" or "Per36".
+func MockNBALeagueLeaders(category, mode string) []api.LeagueLeader {
+	leaders := make([]api.LeagueLeader, 0, len(mockLeaderSeeds))
+	for i, s := range mockLeaderSeeds {
+		scale := 1.0
+		switch mode {
+		case "Totals":
+			scale = float64(s.gp)
+		case "Per36":
+			scale = 36 / s.min
+		}
+
+		l := api.LeagueLeader{
+			PlayerID:    s.team.ID*100 + 1 + i%3, // decodes to the team in MockNBAPlayerProfile
+			Name:        s.name,
+			Team:        api.Team{ID: s.team.ID, ShortName: s.team.ShortName},
+			GamesPlayed: s.gp,
+			Minutes:     s.min * scale,
+		}
+
+		switch category {
+		case "PTS":
+			l.Value = s.pts * scale
+		case "REB":
+			l.Value = s.reb * scale
+		case "AST":
+			l.Value = s.ast * scale
+		case "STL":
+			l.Value = s.stl * scale
+		case "BLK":
+			l.Value = s.blk * scale
+		case "FG_PCT", "FG3_PCT", "FT_PCT":
+			made, attempted := s.fgm, s.fga
+			if category == "FG3_PCT" {
+				made, attempted = s.fg3m, s.fg3a
+			} else if category == "FT_PCT" {
+				made, attempted = s.ftm, s.fta
+			}
+			// Percentage leaders need volume to qualify
+			if attempted < 2 {
+				continue
+			}
+			l.Value = made / attempted
+			l.Made = made * scale
+			l.Attempted = attempted * scale
+		}
+		leaders = append(leaders, l)
+	}
+
+	sort.SliceStable(leaders, func(i, j int) bool { return leaders[i].Value > leaders[j].Value })
+	for i := range leaders {
+		leaders[i].Rank = i + 1
+	}
+	return leaders
+}
@@ -122,20 +122,59 @@ func MockNBAUpcomingMatches() []api.Match {
 
 	return []api.Match{
 		{
-			ID:        9010,
-			League:    api.League{ID: 1, Name: "NBA"},
-			HomeTeam:  nbaTeam(teamNYK, "New York Knicks", "NYK"),
-			AwayTeam:  nbaTeam(teamCLE, "Cleveland Cavaliers", "CLE"),
-			Status:    api.MatchStatusNotStarted,
-			MatchTime: &tonight,
+			ID:           9010,
+			League:       api.League{ID: 1, Name: "NBA"},
+			HomeTeam:     nbaTeam(teamNYK, "New York Knicks", "NYK"),
+			AwayTeam:     nbaTeam(teamCLE, "Cleveland Cavaliers", "CLE"),
+			Status:       api.MatchStatusNotStarted,
+			MatchTime:    &tonight,
+			Broadcasters: []string{"ESPN", "MSG"},
 		},
 		{
-			ID:        9011,
-			League:    api.League{ID: 2, Name: "NBA"},
-			HomeTeam:  nbaTeam(teamPHX, "Phoenix Suns", "PHX"),
-			AwayTeam:  nbaTeam(teamSAC, "Sacramento Kings", "SAC"),
-			Status:    api.MatchStatusNotStarted,
-			MatchTime: &later,
+			ID:           9011,
+			League:       api.League{ID: 2, Name: "NBA"},
+			HomeTeam:     nbaTeam(teamPHX, "Phoenix Suns", "PHX"),
+			AwayTeam:     nbaTeam(teamSAC, "Sacramento Kings", "SAC"),
+			Status:       api.MatchStatusNotStarted,
+			MatchTime:    &later,
+			Broadcasters: []string{"NBA TV", "NBCS-CA"},
 		},
 	}
 }
+
+// MockNBAScheduleMatches returns mock games for an arbitrary calendar day.
+// Today mirrors the live view fixtures; past days reuse the finished games and
+// future days reuse tonight's scheduled games, shifted onto the requested date.
+func MockNBAScheduleMatches(date time.Time) []api.Match {
+	day := date.Local().Format("2006-01-02")
+	today := time.Now().Local().Format("2006-01-02")
+
+	if day == today {
+		return append(MockNBALiveMatches(), MockNBAUpcomingMatches()...)
+	}
+
+	y, mo, d := date.Local().Date()
+	var matches []api.Match
+	if day < today {
+		tipOff := time.Date(y, mo, d, 19, 30, 0, 0, time.Local)
+		for _, m := range MockNBALiveMatches() {
+			if m.Status != api.MatchStatusFinished {
+				continue
+			}
+			t := tipOff
+			m.MatchTime = &t
+			matches = append(matches, m)
+			tipOff = tipOff.Add(90 * time.Minute)
+		}
+		return matches
+	}
+
+	broadcasters := [][]string{{"ESPN"}, {"NBA TV", "NBCS-CA"}}
+	for i, m := range MockNBAUpcomingMatches() {
+		t := time.Date(y, mo, d, m.MatchTime.Hour(), m.MatchTime.Minute(), 0, 0, time.Local)
+		m.MatchTime = &t
+		m.Broadcasters = broadcasters[i%len(broadcasters)]
+		matches = append(matches, m)
+	}
+	return matches
+}
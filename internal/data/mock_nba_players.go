@@ -0,0 +1,109 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// MockNBAPlayerProfile returns a player profile for a box score row in the mock fixtures.
+// Mock player IDs encode the team (teamID*100 + roster slot), so the team is recovered
+// from the ID and named from the mock fixtures. The game log ends with matchID so the
+// current game is highlighted.
+func MockNBAPlayerProfile(playerID int, name string, matchID int) (*api.PlayerProfile, error) {
+	if playerID == 0 {
+		return nil, fmt.Errorf("mock player has no ID")
+	}
+
+	teamID := playerID / 100
+	slot := playerID % 100
+	team := api.Team{ID: teamID}
+	for _, o := range mockOpponents {
+		if o.ID == teamID {
+			team = o
+		}
+	}
+	for _, m := range MockNBAScheduleMatches(time.Now()) {
+		if m.HomeTeam.ID == teamID {
+			team = m.HomeTeam
+		} else if m.AwayTeam.ID == teamID {
+			team = m.AwayTeam
+		}
+	}
+
+	// Earlier roster slots are the better players in the fixtures
+	scale := 1.0 - float64(slot-1)*0.12
+	profile := &api.PlayerProfile{
+		ID:         playerID,
+		Name:       name,
+		Team:       team,
+		Position:   "Guard",
+		Jersey:     fmt.Sprintf("%d", slot*3),
+		Height:     "6-5",
+		Weight:     "205",
+		Age:        24 + slot,
+		Country:    "USA",
+		School:     "Kentucky",
+		Experience: 2 + slot,
+		Draft:      fmt.Sprintf("%d R1 #%d", 2024-slot-2, slot*4),
+		Season:     "2025-26",
+		Averages: &api.PlayerSeasonAverages{
+			GamesPlayed:  58,
+			GamesStarted: 58 - slot*4,
+			Minutes:      34.2 * scale,
+			Points:       27.1 * scale,
+			Rebounds:     6.4 * scale,
+			Assists:      5.2 * scale,
+			Steals:       1.3 * scale,
+			Blocks:       0.6 * scale,
+			Turnovers:    2.8 * scale,
+			FGM:          9.6 * scale,
+			FGA:          20.3 * scale,
+			FGPct:        0.473,
+			FG3M:         3.1 * scale,
+			FG3A:         8.4 * scale,
+			FG3Pct:       0.369,
+			FTM:          4.8 * scale,
+			FTA:          5.6 * scale,
+			FTPct:        0.857,
+		},
+	}
+
+	today := time.Now()
+	var opponents []string
+	for _, o := range []string{"NYK", "MIA", "DEN", "CLE", "OKC", "PHX", "GSW", "LAL", "PHI", "MIL", "BOS"} {
+		if o != team.ShortName {
+			opponents = append(opponents, o)
+		}
+	}
+	for i := 0; i < 10; i++ {
+		pts := int(float64(18+(i*7)%17) * scale)
+		entry := api.PlayerGameLogEntry{
+			MatchID:   9300 + i,
+			Date:      today.AddDate(0, 0, -2*i),
+			Matchup:   fmt.Sprintf("%s vs. %s", team.ShortName, opponents[i]),
+			Result:    []string{"W", "L"}[i%3/2],
+			Minutes:   30 + i%6,
+			Points:    pts,
+			Rebounds:  4 + i%5,
+			Assists:   3 + i%4,
+			FGM:       pts / 2,
+			FGA:       pts/2 + 8,
+			FG3M:      i % 4,
+			FG3A:      4 + i%4,
+			FTM:       2 + i%3,
+			FTA:       3 + i%3,
+			PlusMinus: 6 - i%9,
+		}
+		if i%2 == 1 {
+			entry.Matchup = fmt.Sprintf("%s @ %s", team.ShortName, opponents[i])
+		}
+		if i == 0 {
+			entry.MatchID = matchID
+		}
+		profile.GameLog = append(profile.GameLog, entry)
+	}
+
+	return profile, nil
+}
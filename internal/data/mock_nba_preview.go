@@ -0,0 +1,85 @@
+package data
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// MockNBAGamePreview returns a pre-game preview for a scheduled mock game.
+// Recent results come from the mock team pages; the season series has one
+// earlier meeting so both played and upcoming games show.
+func MockNBAGamePreview(match api.Match) (*api.GamePreview, error) {
+	home, err := mockPreviewTeam(match.HomeTeam, 14, 6, 4, "W3", 0)
+	if err != nil {
+		return nil, err
+	}
+	away, err := mockPreviewTeam(match.AwayTeam, 11, 9, 7, "L1", 2)
+	if err != nil {
+		return nil, err
+	}
+
+	tipOff := time.Now().AddDate(0, 0, -24)
+	earlier := mockTeamGame(9400, match.AwayTeam, match.HomeTeam, true, tipOff)
+	finalStr := "Final"
+	earlier.Status = api.MatchStatusFinished
+	earlier.LiveTime = &finalStr
+	earlier.HomeScore, earlier.AwayScore = intPtr(108), intPtr(113)
+
+	later := mockTeamGame(9401, match.AwayTeam, match.HomeTeam, true, time.Now().AddDate(0, 2, 0))
+	later.Status = api.MatchStatusNotStarted
+
+	return &api.GamePreview{
+		MatchID:      match.ID,
+		Home:         *home,
+		Away:         *away,
+		SeasonSeries: []api.Match{earlier, match, later},
+	}, nil
+}
+
+// mockPreviewTeam builds one side of a mock preview from the team's mock page.
+// skip offsets the recent results so the two sides show different form.
+func mockPreviewTeam(team api.Team, wins, losses, seed int, streak string, skip int) (*api.PreviewTeam, error) {
+	profile, err := MockNBATeamProfile(team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	side := &api.PreviewTeam{
+		Team:           team,
+		Wins:           wins,
+		Losses:         losses,
+		Conference:     "East",
+		ConferenceRank: seed,
+		Streak:         streak,
+	}
+	for i := len(profile.Schedule) - 1; i >= 0 && len(side.LastFive) < 5; i-- {
+		if profile.Schedule[i].Status != api.MatchStatusFinished {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		side.LastFive = append(side.LastFive, profile.Schedule[i])
+	}
+
+	// Teams with leaders in the fixtures start them; others use the mock roster
+	for _, s := range mockLeaderSeeds {
+		if s.team.ID == team.ID {
+			side.Starters = append(side.Starters, s.name)
+			side.TopScorers = append(side.TopScorers, api.PreviewScorer{Name: s.name, Points: s.pts, Rebounds: s.reb, Assists: s.ast})
+		}
+	}
+	if len(side.Starters) == 0 {
+		for _, p := range profile.Roster[:min(5, len(profile.Roster))] {
+			side.Starters = append(side.Starters, p.Name)
+		}
+	}
+	sort.SliceStable(side.TopScorers, func(i, j int) bool { return side.TopScorers[i].Points > side.TopScorers[j].Points })
+	if len(side.TopScorers) > 3 {
+		side.TopScorers = side.TopScorers[:3]
+	}
+	return side, nil
+}
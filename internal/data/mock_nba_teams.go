@@ -0,0 +1,138 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// mockOpponents is the rotation of opponents used to build mock team schedules.
+var mockOpponents = []api.Team{
+	nbaTeam(teamNYK, "New York Knicks", "NYK"),
+	nbaTeam(teamDEN, "Denver Nuggets", "DEN"),
+	nbaTeam(teamCLE, "Cleveland Cavaliers", "CLE"),
+	nbaTeam(teamGSW, "Golden State Warriors", "GSW"),
+	nbaTeam(teamMIL, "Milwaukee Bucks", "MIL"),
+	nbaTeam(teamOKC, "Oklahoma City Thunder", "OKC"),
+	nbaTeam(teamPHX, "Phoenix Suns", "PHX"),
+	nbaTeam(teamMIA, "Miami Heat", "MIA"),
+	nbaTeam(teamLAL, "Los Angeles Lakers", "LAL"),
+	nbaTeam(teamPHI, "Philadelphia 76ers", "PHI"),
+}
+
+// MockNBATeamProfile returns a team page for any team that appears in the mock
+// fixtures: ten results, today's game if there is one, and five upcoming games.
+func MockNBATeamProfile(teamID int) (*api.TeamProfile, error) {
+	today := MockNBAScheduleMatches(time.Now())
+
+	var team api.Team
+	var todays *api.Match
+	for i, m := range today {
+		if m.HomeTeam.ID == teamID || m.AwayTeam.ID == teamID {
+			team = m.HomeTeam
+			if m.AwayTeam.ID == teamID {
+				team = m.AwayTeam
+			}
+			todays = &today[i]
+			break
+		}
+	}
+	if todays == nil {
+		return nil, fmt.Errorf("mock team %d not found", teamID)
+	}
+
+	y, mo, d := time.Now().Local().Date()
+	profile := &api.TeamProfile{Team: team, Season: "2025-26"}
+
+	opponents := make([]api.Team, 0, len(mockOpponents))
+	for _, o := range mockOpponents {
+		if o.ID != teamID {
+			opponents = append(opponents, o)
+		}
+	}
+
+	finalStr := "Final"
+	for i := 10; i >= 1; i-- {
+		tipOff := time.Date(y, mo, d-2*i, 19, 30, 0, 0, time.Local)
+		own, opp := 104+(i*7)%15, 98+(i*11)%17
+		m := mockTeamGame(9100+i, team, opponents[i%len(opponents)], i%2 == 0, tipOff)
+		m.Status = api.MatchStatusFinished
+		m.LiveTime = &finalStr
+		if m.HomeTeam.ID == teamID {
+			m.HomeScore, m.AwayScore = intPtr(own), intPtr(opp)
+		} else {
+			m.HomeScore, m.AwayScore = intPtr(opp), intPtr(own)
+		}
+		profile.Schedule = append(profile.Schedule, m)
+		if own > opp {
+			profile.Wins++
+		} else {
+			profile.Losses++
+		}
+	}
+
+	profile.Schedule = append(profile.Schedule, *todays)
+
+	for i := 1; i <= 5; i++ {
+		tipOff := time.Date(y, mo, d+2*i, 19, 0, 0, 0, time.Local)
+		m := mockTeamGame(9200+i, team, opponents[(i+3)%len(opponents)], i%2 == 1, tipOff)
+		m.Status = api.MatchStatusNotStarted
+		m.Broadcasters = []string{"NBA TV"}
+		profile.Schedule = append(profile.Schedule, m)
+	}
+
+	profile.Roster = mockRoster(teamID)
+	profile.Averages = []api.TeamStatRank{
+		{Key: "pts", Label: "Points", Value: 117.4, Rank: 5},
+		{Key: "reb", Label: "Rebounds", Value: 45.1, Rank: 9},
+		{Key: "ast", Label: "Assists", Value: 26.8, Rank: 11},
+		{Key: "stl", Label: "Steals", Value: 7.9, Rank: 14},
+		{Key: "blk", Label: "Blocks", Value: 5.6, Rank: 8},
+		{Key: "tov", Label: "Turnovers", Value: 12.3, Rank: 4},
+		{Key: "oreb", Label: "Off. Rebounds", Value: 11.2, Rank: 12},
+		{Key: "fg_pct", Label: "FG%", Value: 0.478, Rank: 6},
+		{Key: "fg3_pct", Label: "3P%", Value: 0.371, Rank: 10},
+		{Key: "ft_pct", Label: "FT%", Value: 0.802, Rank: 7},
+		{Key: "plus_minus", Label: "+/-", Value: 4.2, Rank: 6},
+	}
+
+	return profile, nil
+}
+
+// mockTeamGame builds a game between team and opponent at the given tip-off.
+func mockTeamGame(id int, team, opponent api.Team, home bool, tipOff time.Time) api.Match {
+	m := api.Match{
+		ID:        id,
+		League:    api.League{Name: "NBA"},
+		HomeTeam:  team,
+		AwayTeam:  opponent,
+		MatchTime: &tipOff,
+	}
+	if !home {
+		m.HomeTeam, m.AwayTeam = opponent, team
+	}
+	return m
+}
+
+// mockRoster returns a roster fixture; Boston has a full one, other teams a short one.
+func mockRoster(teamID int) []api.RosterPlayer {
+	if teamID == teamBOS {
+		return []api.RosterPlayer{
+			{ID: 1628369, Name: "Jayson Tatum", Number: "0", Position: "F", Height: "6-8", Weight: "210", Age: 27, Experience: "8", School: "Duke"},
+			{ID: 1627759, Name: "Jaylen Brown", Number: "7", Position: "G-F", Height: "6-6", Weight: "223", Age: 29, Experience: "9", School: "California"},
+			{ID: 1628401, Name: "Derrick White", Number: "9", Position: "G", Height: "6-4", Weight: "190", Age: 31, Experience: "8", School: "Colorado"},
+			{ID: 1630202, Name: "Payton Pritchard", Number: "11", Position: "G", Height: "6-1", Weight: "195", Age: 27, Experience: "5", School: "Oregon"},
+			{ID: 1628464, Name: "Neemias Queta", Number: "88", Position: "C", Height: "7-0", Weight: "248", Age: 26, Experience: "4", School: "Utah State"},
+			{ID: 1631123, Name: "Sam Hauser", Number: "30", Position: "F", Height: "6-7", Weight: "217", Age: 27, Experience: "4", School: "Virginia"},
+			{ID: 1642255, Name: "Hugo Gonzalez", Number: "28", Position: "F", Height: "6-6", Weight: "205", Age: 19, Experience: "R", School: "Real Madrid"},
+		}
+	}
+	return []api.RosterPlayer{
+		{ID: teamID*10 + 1, Name: "Starting Guard", Number: "1", Position: "G", Height: "6-3", Weight: "195", Age: 26, Experience: "5"},
+		{ID: teamID*10 + 2, Name: "Starting Wing", Number: "3", Position: "G-F", Height: "6-6", Weight: "215", Age: 25, Experience: "4"},
+		{ID: teamID*10 + 3, Name: "Starting Forward", Number: "8", Position: "F", Height: "6-9", Weight: "230", Age: 28, Experience: "7"},
+		{ID: teamID*10 + 4, Name: "Starting Center", Number: "21", Position: "C", Height: "7-0", Weight: "250", Age: 30, Experience: "9"},
+		{ID: teamID*10 + 5, Name: "Sixth Man", Number: "10", Position: "G", Height: "6-2", Weight: "185", Age: 22, Experience: "R"},
+	}
+}
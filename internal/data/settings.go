@@ -1,10 +1,12 @@
 package data
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
 
+	"github.com/gabriel7419/courtside/internal/api"
 	"gopkg.in/yaml.v3"
 )
 
@@ -154,9 +156,149 @@ var AllSupportedLeagues = map[string][]LeagueInfo{
 
 // Settings represents user preferences stored in settings.yaml.
 type Settings struct {
+	// Version is the schema version the file was written with (see SettingsVersion).
+	Version int `yaml:"version,omitempty"`
+
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
-	SelectedLeagues []int `yaml:"selected_leagues"`
+	SelectedLeagues []int `yaml:"selected_leagues,omitempty"`
+
+	// FavoriteTeams contains the tricodes of the user's favorite NBA teams ("BOS").
+	FavoriteTeams []string `yaml:"favorite_teams,omitempty"`
+
+	// Conferences limits the game lists to games with a team from these
+	// conferences ("East", "West"). If empty, every game is listed.
+	Conferences []string `yaml:"conferences,omitempty"`
+
+	// Notifications configures which game events notify and how.
+	Notifications NotificationSettings `yaml:"notifications,omitempty"`
+
+	// Highlights configures where highlight clips of notable plays come from.
+	Highlights HighlightSettings `yaml:"highlights,omitempty"`
+
+	// Reddit holds the credentials of a Reddit app; without them Reddit's
+	// public JSON endpoints are used.
+	Reddit RedditSettings `yaml:"reddit,omitempty"`
+
+	// Refresh configures how often live data is refreshed.
+	Refresh RefreshSettings `yaml:"refresh,omitempty"`
+
+	// NBA configures the NBA Stats API client's timeouts, rate and cache.
+	NBA NBASettings `yaml:"nba,omitempty"`
+}
+
+// RedditSettings are the credentials of a Reddit app (reddit.com/prefs/apps).
+// A "script" app signs in with its secret and the owner's username and
+// password; an app with a secret alone or an "installed" app with just a
+// client ID reads anonymously.
+type RedditSettings struct {
+	ClientID     string `yaml:"client_id,omitempty"`
+	ClientSecret string `yaml:"client_secret,omitempty"`
+	Username     string `yaml:"username,omitempty"`
+	Password     string `yaml:"password,omitempty"`
+	UserAgent    string `yaml:"user_agent,omitempty"` // default: "courtside:<client id>:v1 (by /u/<username>)"
+}
+
+// Highlight source types.
+const (
+	HighlightSourceReddit = "reddit" // r/nba Highlight posts
+	HighlightSourceFeed   = "feed"   // JSON feed of clips at a URL
+	HighlightSourceFile   = "file"   // local JSON file of curated clips
+)
+
+// HighlightSettings configures the sources of highlight clips.
+type HighlightSettings struct {
+	// Sources are asked for clips in order, later ones only for the plays
+	// earlier ones found no sure clip of (default: reddit).
+	Sources []HighlightSource `yaml:"sources,omitempty"`
+
+	// Parallel asks every source at once instead.
+	Parallel bool `yaml:"parallel,omitempty"`
+}
+
+// HighlightSource is a source of highlight clips.
+type HighlightSource struct {
+	Name string `yaml:"name,omitempty"`
+	Type string `yaml:"type"`           // reddit, feed or file
+	URL  string `yaml:"url,omitempty"`  // feed URL
+	Path string `yaml:"path,omitempty"` // file path, relative to the config directory
+}
+
+// Notification actions a rule can map to.
+const (
+	NotifyActionDesktop = "desktop" // desktop popup with a terminal bell, plus every sink
+	NotifyActionBell    = "bell"    // terminal bell only
+	NotifyActionLog     = "log"     // silently appended to the notification log
+	NotifyActionNone    = "none"    // dropped
+)
+
+// NotificationSettings configures game event notifications.
+type NotificationSettings struct {
+	// Rules are checked in order; the first matching rule decides the action.
+	Rules []NotificationRule `yaml:"rules,omitempty"`
+
+	// DefaultAction applies to events no rule matches (default: desktop).
+	DefaultAction string `yaml:"default_action,omitempty"`
+
+	// Sinks receive every notification the desktop action sends.
+	Sinks []NotificationSink `yaml:"sinks,omitempty"`
+
+	// QuietHours turns desktop notifications and bells into log entries during
+	// the given local hours; they are summarized once quiet hours end.
+	QuietHours *QuietHours `yaml:"quiet_hours,omitempty"`
+
+	// ReminderMinutes is how long before tip-off game reminders fire (default: 15).
+	ReminderMinutes int `yaml:"reminder_minutes,omitempty"`
+}
+
+// QuietHours is a daily time range in local time, "23:00" to "07:30".
+// The range may cross midnight.
+type QuietHours struct {
+	Start string `yaml:"start"`
+	End   string `yaml:"end"`
+}
+
+// Notification sink types.
+const (
+	SinkTypeWebhook = "webhook" // HTTP POST of the event JSON or a templated body
+	SinkTypeExec    = "exec"    // command run with the event JSON on stdin
+)
+
+// NotificationSink is an extra destination for notifications, such as a
+// Slack, Discord or ntfy webhook or a user script.
+type NotificationSink struct {
+	Name     string            `yaml:"name,omitempty"`
+	Type     string            `yaml:"type"`               // webhook or exec
+	URL      string            `yaml:"url,omitempty"`      // webhook endpoint
+	Headers  map[string]string `yaml:"headers,omitempty"`  // extra webhook request headers
+	Template string            `yaml:"template,omitempty"` // Go template for the webhook body; default is the event JSON
+	Command  []string          `yaml:"command,omitempty"`  // exec program and arguments
+	Events   []string          `yaml:"events,omitempty"`   // event types sent to this sink; empty means all
+	Timeout  string            `yaml:"timeout,omitempty"`  // per attempt, "10s" (default)
+	Retries  int               `yaml:"retries,omitempty"`  // extra attempts after a failure
+}
+
+// NotifyEvents lists the event types notification rules and sinks can name;
+// they are the notify package's EventTypes.
+var NotifyEvents = []string{
+	"score", "correction", "tip_off", "end_of_period", "halftime", "final", "overtime",
+	"lead_change", "close_game", "big_run", "ejection", "milestone", "rule", "summary", "reminder",
+}
+
+// NotificationRule maps conditions on a live game to a notification action.
+// Unset conditions match anything. A rule with Events matches those game events;
+// a rule without Events is a condition on the game itself and fires once each
+// time the condition becomes true.
+type NotificationRule struct {
+	Name      string   `yaml:"name,omitempty"`
+	Events    []string `yaml:"events,omitempty"`     // event types such as "close_game" or "overtime"
+	Teams     []string `yaml:"teams,omitempty"`      // tricodes; "favorites" means FavoriteTeams
+	MaxMargin *int     `yaml:"max_margin,omitempty"` // largest score difference
+	MinPeriod int      `yaml:"min_period,omitempty"` // 4 = Q4, 5 = first overtime
+	MaxClock  string   `yaml:"max_clock,omitempty"`  // most time left in the period, "5:00"
+	Player    string   `yaml:"player,omitempty"`     // player name, full or "J. Tatum"
+	MinPoints int      `yaml:"min_points,omitempty"` // points the player has reached
+	Action    string   `yaml:"action"`               // desktop, bell, log or none
 }
 
 // SettingsPath returns the path to the settings file.
@@ -194,19 +336,50 @@ func LoadSettings() (*Settings, error) {
 	return &settings, nil
 }
 
-// SaveSettings writes settings to the settings.yaml file.
+// ReadSettings reads the settings file like LoadSettings, but fails on YAML
+// that doesn't parse instead of falling back to defaults, so that settings
+// changed and saved back don't replace a file with a typo in it.
+func ReadSettings() (*Settings, error) {
+	path, err := SettingsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Settings{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var settings Settings
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &settings, nil
+}
+
+// SaveSettings writes settings to the settings.yaml file, stamped with the
+// current SettingsVersion. The file is readable by the user only.
 func SaveSettings(settings *Settings) error {
 	path, err := SettingsPath()
 	if err != nil {
 		return err
 	}
 
+	settings.Version = SettingsVersion
 	data, err := yaml.Marshal(settings)
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	// settings.yaml can hold the Reddit secret and password, so only the
+	// user may read it; WriteFile keeps the mode of a file already there
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return err
+	}
+	return os.Chmod(path, 0600)
 }
 
 // DefaultLeagueIDs contains the default leagues used when no selection is made.
@@ -245,6 +418,48 @@ func AllLeagueIDs() []int {
 	return ids
 }
 
+// ShowsMatch reports whether the game lists include match under the
+// Conferences filter. Games of teams outside the NBA, such as the All-Star
+// Game, are always listed.
+func (s *Settings) ShowsMatch(match api.Match) bool {
+	if s == nil || len(s.Conferences) == 0 {
+		return true
+	}
+	known := false
+	for _, team := range []api.Team{match.HomeTeam, match.AwayTeam} {
+		info, ok := TeamByID(team.ID)
+		if !ok {
+			info, ok = TeamByTricode(team.ShortName)
+		}
+		if !ok {
+			continue
+		}
+		known = true
+		if slices.Contains(s.Conferences, info.Conference) {
+			return true
+		}
+	}
+	return !known
+}
+
+// IsFavoriteTeam reports whether team is one of FavoriteTeams, by tricode
+// or, when it has none, by NBA team ID.
+func (s *Settings) IsFavoriteTeam(team api.Team) bool {
+	if s == nil || len(s.FavoriteTeams) == 0 {
+		return false
+	}
+	tricode := team.ShortName
+	if info, ok := TeamByID(team.ID); ok {
+		tricode = info.Tricode
+	}
+	return tricode != "" && slices.Contains(s.FavoriteTeams, tricode)
+}
+
+// IsFavoriteMatch reports whether either team of match is a favorite.
+func (s *Settings) IsFavoriteMatch(match api.Match) bool {
+	return s.IsFavoriteTeam(match.HomeTeam) || s.IsFavoriteTeam(match.AwayTeam)
+}
+
 // IsLeagueSelected checks if a league ID is in the selected list.
 func (s *Settings) IsLeagueSelected(leagueID int) bool {
 	return slices.Contains(s.SelectedLeagues, leagueID)
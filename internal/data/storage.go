@@ -13,58 +13,79 @@ import (
 	"time"
 )
 
-const (
-	configDir = ".golazo"
-)
-
-// ConfigDir returns the path to the golazo config directory.
-// On Linux, follows XDG Base Directory spec (~/.config/golazo).
-// On other systems (macOS, Windows), uses ~/.golazo.
+// appName names courtside's config, cache and state directories.
+const appName = "courtside"
+
+// ConfigDir returns the path to the courtside config directory, for
+// settings.yaml and the files it refers to.
+//   - $XDG_CONFIG_HOME/courtside when XDG_CONFIG_HOME is set
+//   - Linux: ~/.config/courtside
+//   - macOS: ~/Library/Application Support/courtside
+//   - Windows: %AppData%/courtside
 func ConfigDir() (string, error) {
-	var configPath string
-
-	if runtime.GOOS == "linux" {
-		if xdgConfig := os.Getenv("XDG_CONFIG_HOME"); xdgConfig != "" {
-			configPath = filepath.Join(xdgConfig, "golazo")
-		} else {
-			homeDir, err := os.UserHomeDir()
-			if err != nil {
-				return "", fmt.Errorf("get home directory: %w", err)
-			}
-			configPath = filepath.Join(homeDir, ".config", "golazo")
+	return appDir("XDG_CONFIG_HOME", "config", func() (string, error) {
+		dir, err := os.UserConfigDir()
+		return filepath.Join(dir, appName), err
+	})
+}
+
+// CacheDir returns the path to the courtside cache directory, for data that
+// can be fetched again such as the Reddit caches.
+//   - $XDG_CACHE_HOME/courtside when XDG_CACHE_HOME is set
+//   - Linux: ~/.cache/courtside
+//   - macOS: ~/Library/Caches/courtside
+//   - Windows: %LocalAppData%/courtside
+func CacheDir() (string, error) {
+	return appDir("XDG_CACHE_HOME", "cache", func() (string, error) {
+		dir, err := os.UserCacheDir()
+		return filepath.Join(dir, appName), err
+	})
+}
+
+// StateDir returns the path to the courtside state directory, for data kept
+// between runs that isn't configuration: notification history and reminders,
+// how far games were followed, the daemon's PID file and logs.
+//   - $XDG_STATE_HOME/courtside when XDG_STATE_HOME is set
+//   - Linux: ~/.local/state/courtside
+//   - macOS and Windows: the state directory inside the config directory
+func StateDir() (string, error) {
+	return appDir("XDG_STATE_HOME", "state", func() (string, error) {
+		if runtime.GOOS == "linux" {
+			home, err := os.UserHomeDir()
+			return filepath.Join(home, ".local", "state", appName), err
 		}
+		dir, err := os.UserConfigDir()
+		return filepath.Join(dir, appName, "state"), err
+	})
+}
+
+// appDir creates and returns the courtside directory of a kind: under the
+// base directory in the XDG environment variable xdgEnv when that is set,
+// and at the platform's path from fallback otherwise.
+func appDir(xdgEnv, kind string, fallback func() (string, error)) (string, error) {
+	var path string
+	if base := os.Getenv(xdgEnv); filepath.IsAbs(base) {
+		path = filepath.Join(base, appName)
 	} else {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return "", fmt.Errorf("get home directory: %w", err)
+		var err error
+		if path, err = fallback(); err != nil {
+			return "", fmt.Errorf("get user %s directory: %w", kind, err)
 		}
-		configPath = filepath.Join(homeDir, configDir)
 	}
 
-	if err := os.MkdirAll(configPath, 0755); err != nil {
-		return "", fmt.Errorf("create config directory: %w", err)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return "", fmt.Errorf("create %s directory: %w", kind, err)
 	}
-
-	return configPath, nil
+	return path, nil
 }
 
-// CacheDir returns the path to the golazo cache directory.
-// Uses os.UserCacheDir() which returns:
-//   - Linux: ~/.cache/golazo (or $XDG_CACHE_HOME/golazo)
-//   - macOS: ~/Library/Caches/golazo
-//   - Windows: %LocalAppData%/golazo
-func CacheDir() (string, error) {
-	userCache, err := os.UserCacheDir()
+// DebugLogPath returns the path of the --debug log file.
+func DebugLogPath() (string, error) {
+	dir, err := StateDir()
 	if err != nil {
-		return "", fmt.Errorf("get user cache directory: %w", err)
-	}
-
-	cachePath := filepath.Join(userCache, "golazo")
-	if err := os.MkdirAll(cachePath, 0755); err != nil {
-		return "", fmt.Errorf("create cache directory: %w", err)
+		return "", err
 	}
-
-	return cachePath, nil
+	return filepath.Join(dir, "courtside_debug.log"), nil
 }
 
 // MockDataPath returns the path to the mock data file.
@@ -85,7 +106,7 @@ type LiveUpdate struct {
 
 // SaveLiveUpdate appends a live update to the storage.
 func SaveLiveUpdate(matchID int, update string) error {
-	dir, err := ConfigDir()
+	dir, err := CacheDir()
 	if err != nil {
 		return err
 	}
@@ -117,7 +138,7 @@ func SaveLiveUpdate(matchID int, update string) error {
 
 // LiveUpdates retrieves live updates for a match.
 func LiveUpdates(matchID int) ([]string, error) {
-	dir, err := ConfigDir()
+	dir, err := CacheDir()
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +165,7 @@ func LiveUpdates(matchID int) ([]string, error) {
 // LoadLatestVersion reads the latest known version from storage.
 // Returns empty string if file doesn't exist or can't be read.
 func LoadLatestVersion() (string, error) {
-	dir, err := ConfigDir()
+	dir, err := StateDir()
 	if err != nil {
 		return "", err
 	}
@@ -160,7 +181,7 @@ func LoadLatestVersion() (string, error) {
 
 // SaveLatestVersion saves the latest version to storage.
 func SaveLatestVersion(version string) error {
-	dir, err := ConfigDir()
+	dir, err := StateDir()
 	if err != nil {
 		return err
 	}
@@ -218,7 +239,7 @@ func CheckLatestVersion() (string, error) {
 // ShouldCheckVersion returns true if we should check for a new version.
 // Checks if the latest_version.txt file is older than 24 hours.
 func ShouldCheckVersion() bool {
-	dir, err := ConfigDir()
+	dir, err := StateDir()
 	if err != nil {
 		return false
 	}
@@ -0,0 +1,88 @@
+package data
+
+// Conferences, as in Settings.Conferences and TeamInfo.Conference.
+const (
+	ConferenceEast = "East"
+	ConferenceWest = "West"
+)
+
+// TeamInfo contains NBA team metadata for display and filtering.
+type TeamInfo struct {
+	ID         int // NBA Stats team ID
+	Tricode    string
+	Name       string
+	Conference string // ConferenceEast or ConferenceWest
+	Division   string
+}
+
+// NBATeams lists the 30 NBA teams by conference, then division.
+var NBATeams = []TeamInfo{
+	// Eastern Conference
+	{ID: 1610612738, Tricode: "BOS", Name: "Boston Celtics", Conference: ConferenceEast, Division: "Atlantic"},
+	{ID: 1610612751, Tricode: "BKN", Name: "Brooklyn Nets", Conference: ConferenceEast, Division: "Atlantic"},
+	{ID: 1610612752, Tricode: "NYK", Name: "New York Knicks", Conference: ConferenceEast, Division: "Atlantic"},
+	{ID: 1610612755, Tricode: "PHI", Name: "Philadelphia 76ers", Conference: ConferenceEast, Division: "Atlantic"},
+	{ID: 1610612761, Tricode: "TOR", Name: "Toronto Raptors", Conference: ConferenceEast, Division: "Atlantic"},
+	{ID: 1610612741, Tricode: "CHI", Name: "Chicago Bulls", Conference: ConferenceEast, Division: "Central"},
+	{ID: 1610612739, Tricode: "CLE", Name: "Cleveland Cavaliers", Conference: ConferenceEast, Division: "Central"},
+	{ID: 1610612765, Tricode: "DET", Name: "Detroit Pistons", Conference: ConferenceEast, Division: "Central"},
+	{ID: 1610612754, Tricode: "IND", Name: "Indiana Pacers", Conference: ConferenceEast, Division: "Central"},
+	{ID: 1610612749, Tricode: "MIL", Name: "Milwaukee Bucks", Conference: ConferenceEast, Division: "Central"},
+	{ID: 1610612737, Tricode: "ATL", Name: "Atlanta Hawks", Conference: ConferenceEast, Division: "Southeast"},
+	{ID: 1610612766, Tricode: "CHA", Name: "Charlotte Hornets", Conference: ConferenceEast, Division: "Southeast"},
+	{ID: 1610612748, Tricode: "MIA", Name: "Miami Heat", Conference: ConferenceEast, Division: "Southeast"},
+	{ID: 1610612753, Tricode: "ORL", Name: "Orlando Magic", Conference: ConferenceEast, Division: "Southeast"},
+	{ID: 1610612764, Tricode: "WAS", Name: "Washington Wizards", Conference: ConferenceEast, Division: "Southeast"},
+	// Western Conference
+	{ID: 1610612743, Tricode: "DEN", Name: "Denver Nuggets", Conference: ConferenceWest, Division: "Northwest"},
+	{ID: 1610612750, Tricode: "MIN", Name: "Minnesota Timberwolves", Conference: ConferenceWest, Division: "Northwest"},
+	{ID: 1610612760, Tricode: "OKC", Name: "Oklahoma City Thunder", Conference: ConferenceWest, Division: "Northwest"},
+	{ID: 1610612757, Tricode: "POR", Name: "Portland Trail Blazers", Conference: ConferenceWest, Division: "Northwest"},
+	{ID: 1610612762, Tricode: "UTA", Name: "Utah Jazz", Conference: ConferenceWest, Division: "Northwest"},
+	{ID: 1610612744, Tricode: "GSW", Name: "Golden State Warriors", Conference: ConferenceWest, Division: "Pacific"},
+	{ID: 1610612746, Tricode: "LAC", Name: "LA Clippers", Conference: ConferenceWest, Division: "Pacific"},
+	{ID: 1610612747, Tricode: "LAL", Name: "Los Angeles Lakers", Conference: ConferenceWest, Division: "Pacific"},
+	{ID: 1610612756, Tricode: "PHX", Name: "Phoenix Suns", Conference: ConferenceWest, Division: "Pacific"},
+	{ID: 1610612758, Tricode: "SAC", Name: "Sacramento Kings", Conference: ConferenceWest, Division: "Pacific"},
+	{ID: 1610612742, Tricode: "DAL", Name: "Dallas Mavericks", Conference: ConferenceWest, Division: "Southwest"},
+	{ID: 1610612745, Tricode: "HOU", Name: "Houston Rockets", Conference: ConferenceWest, Division: "Southwest"},
+	{ID: 1610612763, Tricode: "MEM", Name: "Memphis Grizzlies", Conference: ConferenceWest, Division: "Southwest"},
+	{ID: 1610612740, Tricode: "NOP", Name: "New Orleans Pelicans", Conference: ConferenceWest, Division: "Southwest"},
+	{ID: 1610612759, Tricode: "SAS", Name: "San Antonio Spurs", Conference: ConferenceWest, Division: "Southwest"},
+}
+
+// GetAllConferences returns the conferences in display order.
+func GetAllConferences() []string {
+	return []string{ConferenceEast, ConferenceWest}
+}
+
+// GetTeamsForConference returns a conference's teams by division.
+func GetTeamsForConference(conference string) []TeamInfo {
+	var teams []TeamInfo
+	for _, t := range NBATeams {
+		if t.Conference == conference {
+			teams = append(teams, t)
+		}
+	}
+	return teams
+}
+
+// TeamByID returns the team with an NBA Stats team ID.
+func TeamByID(id int) (TeamInfo, bool) {
+	for _, t := range NBATeams {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return TeamInfo{}, false
+}
+
+// TeamByTricode returns the team with a tricode ("BOS").
+func TeamByTricode(tricode string) (TeamInfo, bool) {
+	for _, t := range NBATeams {
+		if t.Tricode == tricode {
+			return t, true
+		}
+	}
+	return TeamInfo{}, false
+}
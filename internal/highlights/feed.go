@@ -0,0 +1,173 @@
+package highlights
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/reddit"
+)
+
+// FeedRefresh is how long a feed fetched from a URL is used before it is
+// fetched again.
+const FeedRefresh = 5 * time.Minute
+
+// feedTimeout bounds a feed request.
+const feedTimeout = 10 * time.Second
+
+// Feed is a list of clips, as served by a feed URL or kept in a file:
+//
+//	{"clips": [{"title": "Jayson Tatum game-winner vs Heat",
+//	            "url": "https://youtu.be/...",
+//	            "player": "Jayson Tatum", "kind": "game-winner",
+//	            "date": "2025-01-15T02:30:00Z"}]}
+//
+// A clip with match_id and event_id is linked to that play only. Others are
+// matched to plays by title, player and kind like r/nba posts, and to games by
+// date when they have one.
+type Feed struct {
+	Clips []FeedClip `json:"clips"`
+}
+
+// FeedClip is a clip listed in a feed.
+type FeedClip struct {
+	Title   string    `json:"title"`
+	URL     string    `json:"url"`
+	PostURL string    `json:"post_url,omitempty"`
+	MatchID int       `json:"match_id,omitempty"`
+	EventID int       `json:"event_id,omitempty"`
+	Player  string    `json:"player,omitempty"`
+	Kind    string    `json:"kind,omitempty"` // "dunk", "block"... as in nba.NotablePlay
+	Date    time.Time `json:"date,omitzero"`
+	Upvotes int       `json:"upvotes,omitempty"`
+}
+
+// FeedProvider finds clips in a feed.
+type FeedProvider struct {
+	name string
+	load func() (*Feed, error)
+}
+
+// NewFeed creates a provider for the feed at url, fetched at most every
+// FeedRefresh.
+func NewFeed(name, url string) *FeedProvider {
+	client := &http.Client{Timeout: feedTimeout}
+	var mu sync.Mutex
+	var feed *Feed
+	var fetchedAt time.Time
+
+	return &FeedProvider{name: name, load: func() (*Feed, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if feed != nil && time.Since(fetchedAt) < FeedRefresh {
+			return feed, nil
+		}
+		f, err := fetchFeed(client, url)
+		if err != nil {
+			return nil, err
+		}
+		feed, fetchedAt = f, time.Now()
+		return feed, nil
+	}}
+}
+
+// NewFile creates a provider for the feed in a local file, read on every
+// lookup so that edits apply right away.
+func NewFile(name, path string) *FeedProvider {
+	return &FeedProvider{name: name, load: func() (*Feed, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read feed: %w", err)
+		}
+		return parseFeed(b)
+	}}
+}
+
+// fetchFeed fetches and parses the feed at url.
+func fetchFeed(client *http.Client, url string) (*Feed, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("fetch feed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch feed: status %d", resp.StatusCode)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read feed: %w", err)
+	}
+	return parseFeed(b)
+}
+
+// parseFeed parses a feed's JSON.
+func parseFeed(b []byte) (*Feed, error) {
+	var feed Feed
+	if err := json.Unmarshal(b, &feed); err != nil {
+		return nil, fmt.Errorf("parse feed: %w", err)
+	}
+	return &feed, nil
+}
+
+// Name returns the provider's name.
+func (p *FeedProvider) Name() string {
+	return p.name
+}
+
+// Clips returns the feed's clips of the plays.
+func (p *FeedProvider) Clips(plays []reddit.PlayInfo) (map[reddit.PlayKey][]Clip, error) {
+	feed, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	clips := make(map[reddit.PlayKey][]Clip)
+	for _, play := range plays {
+		for _, fc := range feed.Clips {
+			confidence, ok := feedConfidence(fc, play)
+			if !ok || fc.URL == "" {
+				continue
+			}
+			clips[play.Key()] = append(clips[play.Key()], Clip{
+				URL:        fc.URL,
+				Title:      fc.Title,
+				PostURL:    fc.PostURL,
+				Source:     p.name,
+				Confidence: confidence,
+				Upvotes:    fc.Upvotes,
+			})
+		}
+	}
+	return clips, nil
+}
+
+// feedConfidence returns how sure it is that a feed clip shows play, and
+// false when it doesn't. Clips matched by words alone need the player and
+// either the kind of play or a team.
+func feedConfidence(fc FeedClip, play reddit.PlayInfo) (reddit.MatchConfidence, bool) {
+	if fc.MatchID != 0 && fc.MatchID != play.MatchID {
+		return reddit.ConfidenceNone, false
+	}
+	if fc.EventID != 0 {
+		return reddit.ConfidenceHigh, fc.MatchID != 0 && fc.EventID == play.EventID
+	}
+	if fc.MatchID == 0 && !fc.Date.IsZero() && !play.MatchTime.IsZero() {
+		// Same window as r/nba posts: a day before the game to two after
+		if d := fc.Date.Sub(play.MatchTime); d < -24*time.Hour || d > 48*time.Hour {
+			return reddit.ConfidenceNone, false
+		}
+	}
+
+	text := strings.Join([]string{fc.Title, fc.Player, strings.ReplaceAll(fc.Kind, "-", " ")}, " ")
+	confidence := reddit.CalculateConfidence(reddit.SearchResult{Title: text}, play)
+	if fc.MatchID != 0 {
+		return confidence, confidence >= reddit.ConfidenceLow
+	}
+	return confidence, confidence >= reddit.ConfidenceMedium
+}
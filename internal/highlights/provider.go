@@ -0,0 +1,267 @@
+// Package highlights finds highlight clips of notable plays from several
+// sources (r/nba, JSON feeds, local files) and ranks them.
+package highlights
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gabriel7419/courtside/internal/data"
+	"github.com/gabriel7419/courtside/internal/reddit"
+)
+
+// Clip is a candidate highlight clip of a play.
+type Clip struct {
+	URL        string
+	Title      string
+	PostURL    string // discussion page, when the source has one
+	Source     string // name of the provider that found it
+	Confidence reddit.MatchConfidence
+	Upvotes    int
+}
+
+// Provider finds candidate clips of plays.
+type Provider interface {
+	// Name identifies the provider in clips and errors.
+	Name() string
+
+	// Clips returns the clips found for each play, keyed by the play's key.
+	// Plays with none are left out.
+	Clips(plays []reddit.PlayInfo) (map[reddit.PlayKey][]Clip, error)
+}
+
+// CachingProvider is a Provider that keeps the clips it found and can list
+// them without a lookup.
+type CachingProvider interface {
+	Provider
+	Cached(matchID int) map[reddit.PlayKey][]Clip
+}
+
+// ForgettingProvider is a Provider that remembers the plays it found nothing
+// for, and can be told to look for them again.
+type ForgettingProvider interface {
+	Provider
+	Forget(keys []reddit.PlayKey) error
+}
+
+// Providers asks its providers for clips and ranks what they find.
+type Providers struct {
+	providers []Provider
+	parallel  bool
+}
+
+// NewProviders creates a set of providers, asked in the order given or, if
+// parallel, all at once.
+func NewProviders(parallel bool, providers ...Provider) *Providers {
+	return &Providers{providers: providers, parallel: parallel}
+}
+
+// FromSettings builds the providers configured in settings, or just Reddit
+// when none are. Invalid sources are skipped and reported in the returned
+// error; the providers are usable either way.
+func FromSettings(settings *data.Settings, redditClient *reddit.Client) (*Providers, error) {
+	sources := []data.HighlightSource{{Type: data.HighlightSourceReddit}}
+	parallel := false
+	if settings != nil && len(settings.Highlights.Sources) > 0 {
+		sources = settings.Highlights.Sources
+		parallel = settings.Highlights.Parallel
+	}
+
+	var providers []Provider
+	var errs []error
+	for i, cfg := range sources {
+		provider, err := providerFromSettings(cfg, redditClient)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("highlight source %d: %w", i+1, err))
+			continue
+		}
+		providers = append(providers, provider)
+	}
+	return NewProviders(parallel, providers...), errors.Join(errs...)
+}
+
+// providerFromSettings creates the provider described by cfg.
+func providerFromSettings(cfg data.HighlightSource, redditClient *reddit.Client) (Provider, error) {
+	name := cfg.Name
+	if name == "" {
+		name = cfg.Type
+	}
+
+	switch cfg.Type {
+	case data.HighlightSourceReddit:
+		if redditClient == nil {
+			return nil, errors.New("reddit client unavailable")
+		}
+		return NewReddit(name, redditClient), nil
+	case data.HighlightSourceFeed:
+		if cfg.URL == "" {
+			return nil, errors.New("feed without url")
+		}
+		return NewFeed(name, cfg.URL), nil
+	case data.HighlightSourceFile:
+		if cfg.Path == "" {
+			return nil, errors.New("file without path")
+		}
+		path := cfg.Path
+		if !filepath.IsAbs(path) {
+			dir, err := data.ConfigDir()
+			if err != nil {
+				return nil, fmt.Errorf("get config dir: %w", err)
+			}
+			path = filepath.Join(dir, path)
+		}
+		if cfg.Name == "" {
+			name = filepath.Base(path)
+		}
+		return NewFile(name, path), nil
+	}
+	return nil, fmt.Errorf("unknown type %q", cfg.Type)
+}
+
+// Find asks the providers for clips of plays and returns each play's clips,
+// best first and without duplicates. In order, a provider is only asked for
+// the plays the ones before it found no high-confidence clip of. Errors of
+// single providers are joined; the clips of the others are returned anyway.
+func (p *Providers) Find(plays []reddit.PlayInfo) (map[reddit.PlayKey][]Clip, error) {
+	if p == nil || len(p.providers) == 0 || len(plays) == 0 {
+		return nil, nil
+	}
+
+	found := make([]map[reddit.PlayKey][]Clip, len(p.providers))
+	errs := make([]error, len(p.providers))
+	ask := func(i int, plays []reddit.PlayInfo) {
+		provider := p.providers[i]
+		clips, err := provider.Clips(plays)
+		if err != nil {
+			errs[i] = fmt.Errorf("%s: %w", provider.Name(), err)
+		}
+		found[i] = clips
+	}
+
+	if p.parallel {
+		var wg sync.WaitGroup
+		for i := range p.providers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ask(i, plays)
+			}()
+		}
+		wg.Wait()
+	} else {
+		remaining := plays
+		for i := range p.providers {
+			ask(i, remaining)
+			remaining = withoutSureClip(remaining, found[:i+1])
+			if len(remaining) == 0 {
+				break
+			}
+		}
+	}
+
+	return rank(found), errors.Join(errs...)
+}
+
+// Cached returns the clips of a game the providers already found, ranked
+// like Find's, without looking any up.
+func (p *Providers) Cached(matchID int) map[reddit.PlayKey][]Clip {
+	if p == nil {
+		return nil
+	}
+	found := make([]map[reddit.PlayKey][]Clip, len(p.providers))
+	for i, provider := range p.providers {
+		if caching, ok := provider.(CachingProvider); ok {
+			found[i] = caching.Cached(matchID)
+		}
+	}
+	return rank(found)
+}
+
+// Forget makes the providers look again for the plays of keys they found
+// nothing for on an earlier lookup.
+func (p *Providers) Forget(keys []reddit.PlayKey) error {
+	if p == nil {
+		return nil
+	}
+	var errs []error
+	for _, provider := range p.providers {
+		if forgetting, ok := provider.(ForgettingProvider); ok {
+			if err := forgetting.Forget(keys); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// withoutSureClip returns the plays none of found has a high-confidence clip of.
+func withoutSureClip(plays []reddit.PlayInfo, found []map[reddit.PlayKey][]Clip) []reddit.PlayInfo {
+	var remaining []reddit.PlayInfo
+	for _, play := range plays {
+		sure := false
+		for _, clips := range found {
+			for _, clip := range clips[play.Key()] {
+				sure = sure || clip.Confidence >= reddit.ConfidenceHigh
+			}
+		}
+		if !sure {
+			remaining = append(remaining, play)
+		}
+	}
+	return remaining
+}
+
+// rank merges the clips found by each provider, given in provider order, into
+// each play's clips best first: by confidence, then provider order, then
+// upvotes. Of clips with the same URL only the best is kept.
+func rank(found []map[reddit.PlayKey][]Clip) map[reddit.PlayKey][]Clip {
+	type ranked struct {
+		Clip
+		provider int
+	}
+	all := make(map[reddit.PlayKey][]ranked)
+	for i, clips := range found {
+		for key, list := range clips {
+			for _, clip := range list {
+				all[key] = append(all[key], ranked{clip, i})
+			}
+		}
+	}
+
+	result := make(map[reddit.PlayKey][]Clip)
+	for key, list := range all {
+		sort.SliceStable(list, func(i, j int) bool {
+			a, b := list[i], list[j]
+			if a.Confidence != b.Confidence {
+				return a.Confidence > b.Confidence
+			}
+			if a.provider != b.provider {
+				return a.provider < b.provider
+			}
+			return a.Upvotes > b.Upvotes
+		})
+		seen := make(map[string]bool)
+		for _, r := range list {
+			if u := normalizeURL(r.URL); r.URL != "" && !seen[u] {
+				seen[u] = true
+				result[key] = append(result[key], r.Clip)
+			}
+		}
+	}
+	return result
+}
+
+// normalizeURL reduces a clip URL to what identifies the clip, so that
+// "https://www.youtube.com/watch?v=x" and "http://youtube.com/watch?v=x/"
+// are the same.
+func normalizeURL(u string) string {
+	u = strings.TrimSpace(u)
+	u = strings.TrimPrefix(strings.TrimPrefix(u, "https://"), "http://")
+	host, path, _ := strings.Cut(u, "/")
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	return strings.TrimSuffix(host+"/"+path, "/")
+}
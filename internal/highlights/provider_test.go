@@ -0,0 +1,275 @@
+package highlights
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/reddit"
+)
+
+// fakeProvider returns fixed clips and records the plays it is asked for.
+type fakeProvider struct {
+	name  string
+	clips map[reddit.PlayKey][]Clip
+	err   error
+
+	mu    sync.Mutex
+	asked []int // event IDs of the plays asked for
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Clips(plays []reddit.PlayInfo) (map[reddit.PlayKey][]Clip, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	found := make(map[reddit.PlayKey][]Clip)
+	for _, play := range plays {
+		p.asked = append(p.asked, play.EventID)
+		if clips, ok := p.clips[play.Key()]; ok {
+			found[play.Key()] = clips
+		}
+	}
+	return found, p.err
+}
+
+var (
+	tipOff      = time.Date(2025, time.January, 15, 0, 30, 0, 0, time.UTC)
+	gameWinner  = reddit.PlayInfo{MatchID: 42, EventID: 612, Period: 4, Clock: "0:04", HomeTeam: "Boston Celtics", AwayTeam: "Miami Heat", Player: "J. Tatum", Kind: "game-winner", MatchTime: tipOff}
+	blockedShot = reddit.PlayInfo{MatchID: 42, EventID: 580, Period: 4, Clock: "1:10", HomeTeam: "Boston Celtics", AwayTeam: "Miami Heat", Player: "B. Adebayo", Kind: "block", MatchTime: tipOff}
+)
+
+// urls returns the URLs of clips, in order.
+func urls(clips []Clip) []string {
+	var u []string
+	for _, c := range clips {
+		u = append(u, c.URL)
+	}
+	return u
+}
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://www.youtube.com/watch?v=x", "youtube.com/watch?v=x"},
+		{"http://youtube.com/watch?v=x/", "youtube.com/watch?v=x"},
+		{" https://WWW.Streamable.com/abc ", "streamable.com/abc"},
+		{"streamable.com/abc", "streamable.com/abc"},
+		{"https://streamable.com/ABC", "streamable.com/ABC"},
+	}
+	for _, tt := range tests {
+		if got := normalizeURL(tt.url); got != tt.want {
+			t.Errorf("normalizeURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	key := gameWinner.Key()
+	tests := []struct {
+		name  string
+		found []map[reddit.PlayKey][]Clip
+		want  []string
+	}{
+		{
+			name: "by confidence first",
+			found: []map[reddit.PlayKey][]Clip{
+				{key: {{URL: "https://a.com/low", Confidence: reddit.ConfidenceLow, Upvotes: 900}}},
+				{key: {{URL: "https://b.com/high", Confidence: reddit.ConfidenceHigh}}},
+			},
+			want: []string{"https://b.com/high", "https://a.com/low"},
+		},
+		{
+			name: "then by provider order",
+			found: []map[reddit.PlayKey][]Clip{
+				{key: {{URL: "https://a.com/first", Confidence: reddit.ConfidenceMedium}}},
+				{key: {{URL: "https://b.com/second", Confidence: reddit.ConfidenceMedium, Upvotes: 900}}},
+			},
+			want: []string{"https://a.com/first", "https://b.com/second"},
+		},
+		{
+			name: "then by upvotes",
+			found: []map[reddit.PlayKey][]Clip{
+				{key: {
+					{URL: "https://a.com/few", Confidence: reddit.ConfidenceMedium, Upvotes: 10},
+					{URL: "https://a.com/many", Confidence: reddit.ConfidenceMedium, Upvotes: 500},
+				}},
+			},
+			want: []string{"https://a.com/many", "https://a.com/few"},
+		},
+		{
+			name: "same clip kept once, at its best",
+			found: []map[reddit.PlayKey][]Clip{
+				{key: {{URL: "http://youtube.com/watch?v=x/", Confidence: reddit.ConfidenceLow}}},
+				{key: {{URL: "https://www.youtube.com/watch?v=x", Confidence: reddit.ConfidenceHigh}}},
+			},
+			want: []string{"https://www.youtube.com/watch?v=x"},
+		},
+		{
+			name: "clips without a URL dropped",
+			found: []map[reddit.PlayKey][]Clip{
+				{key: {{Title: "no link", Confidence: reddit.ConfidenceHigh}, {URL: "https://a.com/clip"}}},
+				nil,
+			},
+			want: []string{"https://a.com/clip"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := urls(rank(tt.found)[key]); !slices.Equal(got, tt.want) {
+				t.Errorf("rank() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutSureClip(t *testing.T) {
+	plays := []reddit.PlayInfo{gameWinner, blockedShot}
+	tests := []struct {
+		name  string
+		found []map[reddit.PlayKey][]Clip
+		want  []int
+	}{
+		{"nothing found", nil, []int{612, 580}},
+		{"sure clip", []map[reddit.PlayKey][]Clip{{gameWinner.Key(): {{Confidence: reddit.ConfidenceHigh}}}}, []int{580}},
+		{"only a likely clip", []map[reddit.PlayKey][]Clip{{gameWinner.Key(): {{Confidence: reddit.ConfidenceMedium}}}}, []int{612, 580}},
+		{
+			name: "sure clips from different providers",
+			found: []map[reddit.PlayKey][]Clip{
+				{gameWinner.Key(): {{Confidence: reddit.ConfidenceHigh}}},
+				{blockedShot.Key(): {{Confidence: reddit.ConfidenceLow}, {Confidence: reddit.ConfidenceHigh}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, play := range withoutSureClip(plays, tt.found) {
+				got = append(got, play.EventID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("withoutSureClip() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProvidersFind(t *testing.T) {
+	plays := []reddit.PlayInfo{gameWinner, blockedShot}
+	sure := map[reddit.PlayKey][]Clip{gameWinner.Key(): {{URL: "https://a.com/winner", Confidence: reddit.ConfidenceHigh}}}
+	likely := map[reddit.PlayKey][]Clip{
+		gameWinner.Key():  {{URL: "https://b.com/winner", Confidence: reddit.ConfidenceMedium}},
+		blockedShot.Key(): {{URL: "https://b.com/block", Confidence: reddit.ConfidenceMedium}},
+	}
+	tests := []struct {
+		name        string
+		parallel    bool
+		firstErr    error
+		wantAsked   []int // plays the second provider is asked for
+		wantWinner  []string
+		wantBlocked []string
+	}{
+		{
+			name:        "in order",
+			wantAsked:   []int{580},
+			wantWinner:  []string{"https://a.com/winner"},
+			wantBlocked: []string{"https://b.com/block"},
+		},
+		{
+			name:        "in parallel",
+			parallel:    true,
+			wantAsked:   []int{612, 580},
+			wantWinner:  []string{"https://a.com/winner", "https://b.com/winner"},
+			wantBlocked: []string{"https://b.com/block"},
+		},
+		{
+			name:        "a failing provider",
+			firstErr:    errors.New("offline"),
+			wantAsked:   []int{580},
+			wantWinner:  []string{"https://a.com/winner"},
+			wantBlocked: []string{"https://b.com/block"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := &fakeProvider{name: "first", clips: sure, err: tt.firstErr}
+			second := &fakeProvider{name: "second", clips: likely}
+			found, err := NewProviders(tt.parallel, first, second).Find(plays)
+			if !errors.Is(err, tt.firstErr) {
+				t.Errorf("Find() error = %v, want %v", err, tt.firstErr)
+			}
+			if !slices.Equal(second.asked, tt.wantAsked) {
+				t.Errorf("second provider asked for %v, want %v", second.asked, tt.wantAsked)
+			}
+			if got := urls(found[gameWinner.Key()]); !slices.Equal(got, tt.wantWinner) {
+				t.Errorf("game-winner clips = %v, want %v", got, tt.wantWinner)
+			}
+			if got := urls(found[blockedShot.Key()]); !slices.Equal(got, tt.wantBlocked) {
+				t.Errorf("block clips = %v, want %v", got, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestFeedConfidence(t *testing.T) {
+	tests := []struct {
+		name   string
+		clip   FeedClip
+		want   reddit.MatchConfidence
+		wantOK bool
+	}{
+		{"linked to the play", FeedClip{MatchID: 42, EventID: 612}, reddit.ConfidenceHigh, true},
+		{"linked to another play", FeedClip{MatchID: 42, EventID: 613, Title: "Jayson Tatum game-winner vs Heat"}, reddit.ConfidenceHigh, false},
+		{"event without a game", FeedClip{EventID: 612}, reddit.ConfidenceHigh, false},
+		{"another game", FeedClip{MatchID: 7, Title: "Jayson Tatum game-winner vs Heat"}, reddit.ConfidenceNone, false},
+		{"player, play and team", FeedClip{Title: "Jayson Tatum game-winner vs Heat"}, reddit.ConfidenceHigh, true},
+		{"player and kind fields", FeedClip{Title: "Wow", Player: "Jayson Tatum", Kind: "game-winner"}, reddit.ConfidenceMedium, true},
+		{"player and team", FeedClip{Title: "Jayson Tatum against the Heat"}, reddit.ConfidenceMedium, true},
+		{"player alone", FeedClip{Title: "Jayson Tatum postgame"}, reddit.ConfidenceLow, false},
+		{"player alone in the game", FeedClip{MatchID: 42, Title: "Jayson Tatum postgame"}, reddit.ConfidenceLow, true},
+		{"another player", FeedClip{Title: "Jaylen Brown game-winner vs Heat"}, reddit.ConfidenceNone, false},
+		{"within the date window", FeedClip{Title: "Jayson Tatum game-winner vs Heat", Date: tipOff.Add(36 * time.Hour)}, reddit.ConfidenceHigh, true},
+		{"posted days later", FeedClip{Title: "Jayson Tatum game-winner vs Heat", Date: tipOff.Add(72 * time.Hour)}, reddit.ConfidenceNone, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := feedConfidence(tt.clip, gameWinner)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("feedConfidence() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clips.json")
+	feed := `{"clips": [
+		{"title": "Jayson Tatum game-winner vs Heat", "url": "https://youtu.be/winner"},
+		{"title": "Bam Adebayo block on Tatum", "url": "https://youtu.be/block", "match_id": 42, "event_id": 580},
+		{"title": "Jayson Tatum game-winner, no link"}
+	]}`
+	if err := os.WriteFile(path, []byte(feed), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	clips, err := NewFile("curated", path).Clips([]reddit.PlayInfo{gameWinner, blockedShot})
+	if err != nil {
+		t.Fatalf("Clips() error = %v", err)
+	}
+	if got := urls(clips[gameWinner.Key()]); !slices.Equal(got, []string{"https://youtu.be/winner"}) {
+		t.Errorf("game-winner clips = %v, want the titled clip", got)
+	}
+	if got := clips[blockedShot.Key()]; len(got) != 1 || got[0].URL != "https://youtu.be/block" || got[0].Source != "curated" {
+		t.Errorf("block clips = %+v, want the linked clip from curated", got)
+	}
+
+	if _, err := NewFile("missing", filepath.Join(t.TempDir(), "none.json")).Clips([]reddit.PlayInfo{gameWinner}); err == nil {
+		t.Error("Clips() of a missing file = nil error, want one")
+	}
+}
@@ -0,0 +1,58 @@
+package highlights
+
+import "github.com/gabriel7419/courtside/internal/reddit"
+
+// Reddit finds clips in r/nba Highlight posts, one per play at most.
+type Reddit struct {
+	name   string
+	client *reddit.Client
+}
+
+// NewReddit creates a provider searching r/nba through client.
+func NewReddit(name string, client *reddit.Client) *Reddit {
+	return &Reddit{name: name, client: client}
+}
+
+// Name returns the provider's name.
+func (r *Reddit) Name() string {
+	return r.name
+}
+
+// Clips searches r/nba for the plays, within the client's rate limits: the
+// plays left over are searched on a later call.
+func (r *Reddit) Clips(plays []reddit.PlayInfo) (map[reddit.PlayKey][]Clip, error) {
+	clips := make(map[reddit.PlayKey][]Clip)
+	for key, link := range r.client.Highlights(plays) {
+		clips[key] = []Clip{r.clip(*link)}
+	}
+	return clips, nil
+}
+
+// Cached returns the clips of a game found by earlier searches.
+func (r *Reddit) Cached(matchID int) map[reddit.PlayKey][]Clip {
+	clips := make(map[reddit.PlayKey][]Clip)
+	for _, link := range r.client.Cache().All(matchID) {
+		if !reddit.IsNotFound(&link) {
+			clips[link.Key()] = []Clip{r.clip(link)}
+		}
+	}
+	return clips
+}
+
+// Forget drops the plays of keys the earlier searches found no post for, so
+// that they are searched again.
+func (r *Reddit) Forget(keys []reddit.PlayKey) error {
+	return r.client.Cache().ClearNotFound(keys...)
+}
+
+// clip converts a Reddit highlight link to a clip.
+func (r *Reddit) clip(link reddit.Highlight) Clip {
+	return Clip{
+		URL:        link.URL,
+		Title:      link.Title,
+		PostURL:    link.PostURL,
+		Source:     r.name,
+		Confidence: link.Confidence,
+		Upvotes:    link.Upvotes,
+	}
+}
@@ -12,20 +12,20 @@ type CacheConfig struct {
 	MatchesTTL      time.Duration
 	MatchDetailsTTL time.Duration
 	LiveMatchesTTL  time.Duration
+	ScheduleTTL     time.Duration
+	TeamTTL         time.Duration
+	PlayerTTL       time.Duration
+	LeadersTTL      time.Duration
+	PreviewTTL      time.Duration
 	MaxMatchesCache int
 	MaxDetailsCache int
 }
 
 // DefaultCacheConfig returns sensible defaults for the NBA client.
 // Live game data changes frequently; finished game data is permanent.
+// The values are in data.DefaultSettings so settings.yaml can override them.
 func DefaultCacheConfig() CacheConfig {
-	return CacheConfig{
-		MatchesTTL:      30 * time.Second, // scoreboard updates frequently
-		MatchDetailsTTL: 10 * time.Second, // live box score data
-		LiveMatchesTTL:  10 * time.Second, // live game list
-		MaxMatchesCache: 10,
-		MaxDetailsCache: 50,
-	}
+	return DefaultConfig().Cache
 }
 
 type cachedMatches struct {
@@ -33,6 +33,33 @@ type cachedMatches struct {
 	expiresAt time.Time
 }
 
+type cachedTeam struct {
+	profile   *api.TeamProfile
+	expiresAt time.Time
+}
+
+type cachedPlayer struct {
+	profile   *api.PlayerProfile
+	expiresAt time.Time
+}
+
+// playerKey identifies a cached player profile; regular season and playoff
+// game logs are cached separately.
+type playerKey struct {
+	playerID int
+	playoffs bool
+}
+
+type cachedLeaders struct {
+	leaders   []api.LeagueLeader
+	expiresAt time.Time
+}
+
+type cachedPreview struct {
+	preview   *api.GamePreview
+	expiresAt time.Time
+}
+
 type cachedDetails struct {
 	details   *api.MatchDetails
 	expiresAt time.Time
@@ -47,6 +74,16 @@ type ResponseCache struct {
 	detailsCache map[int]cachedDetails // key: gameID
 	liveMu       sync.RWMutex
 	liveCache    *cachedMatches
+	scheduleMu   sync.RWMutex
+	schedule     map[string]cachedMatches // key: season "2025-26"
+	teamMu       sync.RWMutex
+	teamCache    map[int]cachedTeam // key: teamID
+	playerMu     sync.RWMutex
+	playerCache  map[playerKey]cachedPlayer
+	leadersMu    sync.RWMutex
+	leaders      map[LeadersQuery]cachedLeaders
+	previewMu    sync.RWMutex
+	previews     map[int]cachedPreview // key: gameID
 }
 
 // NewResponseCache creates a new cache with the given configuration.
@@ -55,6 +92,11 @@ func NewResponseCache(config CacheConfig) *ResponseCache {
 		config:       config,
 		matchesCache: make(map[string]cachedMatches),
 		detailsCache: make(map[int]cachedDetails),
+		schedule:     make(map[string]cachedMatches),
+		teamCache:    make(map[int]cachedTeam),
+		playerCache:  make(map[playerKey]cachedPlayer),
+		leaders:      make(map[LeadersQuery]cachedLeaders),
+		previews:     make(map[int]cachedPreview),
 	}
 }
 
@@ -144,6 +186,111 @@ func (c *ResponseCache) ClearLive() {
 	c.liveCache = nil
 }
 
+// SeasonSchedule retrieves the cached league schedule for a season, or nil if expired/absent.
+func (c *ResponseCache) SeasonSchedule(season string) []api.Match {
+	c.scheduleMu.RLock()
+	defer c.scheduleMu.RUnlock()
+	cached, ok := c.schedule[season]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.matches
+}
+
+// SetSeasonSchedule stores the league schedule for a season with TTL.
+func (c *ResponseCache) SetSeasonSchedule(season string, matches []api.Match) {
+	c.scheduleMu.Lock()
+	defer c.scheduleMu.Unlock()
+	c.schedule[season] = cachedMatches{
+		matches:   matches,
+		expiresAt: time.Now().Add(c.config.ScheduleTTL),
+	}
+}
+
+// TeamProfile retrieves a cached team profile, or nil if expired/absent.
+func (c *ResponseCache) TeamProfile(teamID int) *api.TeamProfile {
+	c.teamMu.RLock()
+	defer c.teamMu.RUnlock()
+	cached, ok := c.teamCache[teamID]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.profile
+}
+
+// SetTeamProfile stores a team profile in cache with TTL.
+func (c *ResponseCache) SetTeamProfile(teamID int, profile *api.TeamProfile) {
+	c.teamMu.Lock()
+	defer c.teamMu.Unlock()
+	c.teamCache[teamID] = cachedTeam{
+		profile:   profile,
+		expiresAt: time.Now().Add(c.config.TeamTTL),
+	}
+}
+
+// PlayerProfile retrieves a cached player profile, or nil if expired/absent.
+func (c *ResponseCache) PlayerProfile(playerID int, playoffs bool) *api.PlayerProfile {
+	c.playerMu.RLock()
+	defer c.playerMu.RUnlock()
+	cached, ok := c.playerCache[playerKey{playerID, playoffs}]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.profile
+}
+
+// SetPlayerProfile stores a player profile in cache with TTL.
+func (c *ResponseCache) SetPlayerProfile(playerID int, playoffs bool, profile *api.PlayerProfile) {
+	c.playerMu.Lock()
+	defer c.playerMu.Unlock()
+	c.playerCache[playerKey{playerID, playoffs}] = cachedPlayer{
+		profile:   profile,
+		expiresAt: time.Now().Add(c.config.PlayerTTL),
+	}
+}
+
+// Leaders retrieves a cached leaders table, or nil if expired/absent.
+func (c *ResponseCache) Leaders(q LeadersQuery) []api.LeagueLeader {
+	c.leadersMu.RLock()
+	defer c.leadersMu.RUnlock()
+	cached, ok := c.leaders[q]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.leaders
+}
+
+// SetLeaders stores a leaders table in cache with TTL.
+func (c *ResponseCache) SetLeaders(q LeadersQuery, leaders []api.LeagueLeader) {
+	c.leadersMu.Lock()
+	defer c.leadersMu.Unlock()
+	c.leaders[q] = cachedLeaders{
+		leaders:   leaders,
+		expiresAt: time.Now().Add(c.config.LeadersTTL),
+	}
+}
+
+// Preview retrieves a cached game preview, or nil if expired/absent.
+func (c *ResponseCache) Preview(gameID int) *api.GamePreview {
+	c.previewMu.RLock()
+	defer c.previewMu.RUnlock()
+	cached, ok := c.previews[gameID]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.preview
+}
+
+// SetPreview stores a game preview in cache with TTL.
+func (c *ResponseCache) SetPreview(gameID int, preview *api.GamePreview) {
+	c.previewMu.Lock()
+	defer c.previewMu.Unlock()
+	c.previews[gameID] = cachedPreview{
+		preview:   preview,
+		expiresAt: time.Now().Add(c.config.PreviewTTL),
+	}
+}
+
 func (c *ResponseCache) evictOldestMatches() {
 	now := time.Now()
 	var oldestKey string
@@ -0,0 +1,195 @@
+package nba
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// CatchUpRunPoints is the smallest unanswered run listed in a catch-up.
+const CatchUpRunPoints = 8
+
+// maxCatchUpPlays is how many notable plays a catch-up lists, newest first.
+const maxCatchUpPlays = 5
+
+// CatchUp summarizes what happened in a game since the user last saw it.
+type CatchUp struct {
+	Events      int // plays since the last seen one
+	HomeBefore  int
+	AwayBefore  int
+	HomeNow     int
+	AwayNow     int
+	LeadChanges int
+	Runs        []string // "BOS 10-0 run"
+	Plays       []string // "Q3 4:52 J. Brown 3PT", newest first
+	FoulTrouble []string // "B. Adebayo (4 fouls)"
+}
+
+// NewCatchUp summarizes the events of details after sinceEventID, starting
+// from the score the user last saw. It returns nil when nothing happened.
+func NewCatchUp(details *api.MatchDetails, sinceEventID, homeBefore, awayBefore int) *CatchUp {
+	if details == nil {
+		return nil
+	}
+	var events []api.MatchEvent
+	for _, ev := range details.Events {
+		if ev.ID > sinceEventID {
+			events = append(events, ev)
+		}
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	c := &CatchUp{
+		Events:     len(events),
+		HomeBefore: homeBefore,
+		AwayBefore: awayBefore,
+		HomeNow:    homeBefore,
+		AwayNow:    awayBefore,
+	}
+
+	// Replay the scoring from the last seen score for lead changes and runs;
+	// ties keep the previous leader, as in the notifications
+	leader := sign(homeBefore - awayBefore)
+	runTeam, runPoints := 0, 0
+	endRun := func() {
+		if runPoints >= CatchUpRunPoints {
+			c.Runs = append(c.Runs, fmt.Sprintf("%s %d-0 run", teamName(details, runTeam), runPoints))
+		}
+	}
+	for _, ev := range events {
+		if ev.Points == nil || *ev.Points == 0 {
+			continue
+		}
+		if isHomeEvent(ev, details) {
+			c.HomeNow += *ev.Points
+		} else {
+			c.AwayNow += *ev.Points
+		}
+		if lead := sign(c.HomeNow - c.AwayNow); lead != 0 {
+			if leader != 0 && lead != leader {
+				c.LeadChanges++
+			}
+			leader = lead
+		}
+		if ev.Team.ID != runTeam {
+			endRun()
+			runTeam, runPoints = ev.Team.ID, 0
+		}
+		runPoints += *ev.Points
+	}
+	endRun()
+
+	// The box score is authoritative when present; play-by-play can lag
+	if details.HomeScore != nil && details.AwayScore != nil {
+		c.HomeNow, c.AwayNow = *details.HomeScore, *details.AwayScore
+	}
+
+	for i := len(events) - 1; i >= 0 && len(c.Plays) < maxCatchUpPlays; i-- {
+		if play := notablePlay(events[i]); play != "" {
+			c.Plays = append(c.Plays, play)
+		}
+	}
+
+	c.FoulTrouble = foulTrouble(details, sinceEventID)
+	return c
+}
+
+// notablePlay describes a play worth catching up on, or returns "".
+func notablePlay(ev api.MatchEvent) string {
+	player := ""
+	if ev.Player != nil {
+		player = *ev.Player
+	}
+	var what string
+	switch ev.Type {
+	case "field_goal":
+		if ev.IsThree != nil && *ev.IsThree {
+			what = "3PT"
+		}
+	case "ejection":
+		what = "ejected"
+	case "foul":
+		if ev.EventSubtype != nil {
+			switch sub := strings.ToLower(*ev.EventSubtype); {
+			case strings.Contains(sub, "technical"):
+				what = "Technical foul"
+			case strings.Contains(sub, "flagrant"):
+				what = "Flagrant foul"
+			}
+		}
+	case EventTypeMilestone:
+		if ev.EventSubtype != nil {
+			what = *ev.EventSubtype
+		}
+	}
+	if what == "" || player == "" {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", ev.DisplayMinute, player, what))
+}
+
+// foulTrouble lists the players in foul trouble who picked up a foul after
+// sinceEventID: two fouls in the first quarter, three in the second, four in
+// the third and five from the fourth on.
+func foulTrouble(details *api.MatchDetails, sinceEventID int) []string {
+	period := 1
+	if details.Quarter != nil {
+		period = *details.Quarter
+	}
+	limit := min(period+1, 5)
+
+	fouls := make(map[string]int)
+	recent := make(map[string]bool)
+	var order []string
+	for _, ev := range details.Events {
+		if ev.Type != "foul" || ev.Player == nil {
+			continue
+		}
+		name := *ev.Player
+		if fouls[name] == 0 {
+			order = append(order, name)
+		}
+		fouls[name]++
+		if ev.ID > sinceEventID {
+			recent[name] = true
+		}
+	}
+
+	var trouble []string
+	for _, name := range order {
+		if recent[name] && fouls[name] >= limit {
+			trouble = append(trouble, fmt.Sprintf("%s (%d fouls)", name, fouls[name]))
+		}
+	}
+	return trouble
+}
+
+// isHomeEvent reports whether ev belongs to the home team.
+func isHomeEvent(ev api.MatchEvent, details *api.MatchDetails) bool {
+	if ev.Team.ID == 0 && ev.Team.ShortName != "" {
+		return ev.Team.ShortName == details.HomeTeam.ShortName
+	}
+	return ev.Team.ID == details.HomeTeam.ID
+}
+
+// teamName returns the short name of the game's team with the given ID.
+func teamName(details *api.MatchDetails, id int) string {
+	if id == details.AwayTeam.ID {
+		return details.AwayTeam.ShortName
+	}
+	return details.HomeTeam.ShortName
+}
+
+// sign returns 1, -1 or 0.
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
@@ -1,15 +1,18 @@
 package nba
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
 )
 
 const (
@@ -29,13 +32,52 @@ func (c *Client) Cache() *ResponseCache {
 	return c.cache
 }
 
+// Config holds the client's request timeout, request rate and cache settings.
+type Config struct {
+	Timeout         time.Duration // per HTTP request
+	RequestInterval time.Duration // least time between requests
+	Cache           CacheConfig
+}
+
+// DefaultConfig returns the configuration used when settings.yaml sets none.
+func DefaultConfig() Config {
+	return ConfigFromSettings(data.NBASettings{})
+}
+
+// ConfigFromSettings returns the client configuration in the nba section of
+// settings.yaml; unset keys use the defaults in data.DefaultSettings.
+func ConfigFromSettings(settings data.NBASettings) Config {
+	defaults := data.DefaultSettings().NBA
+	return Config{
+		Timeout:         cmp.Or(settings.Timeout, defaults.Timeout),
+		RequestInterval: cmp.Or(settings.RequestInterval, defaults.RequestInterval),
+		Cache: CacheConfig{
+			MatchesTTL:      cmp.Or(settings.Cache.Matches, defaults.Cache.Matches),
+			MatchDetailsTTL: cmp.Or(settings.Cache.MatchDetails, defaults.Cache.MatchDetails),
+			LiveMatchesTTL:  cmp.Or(settings.Cache.LiveMatches, defaults.Cache.LiveMatches),
+			ScheduleTTL:     cmp.Or(settings.Cache.Schedule, defaults.Cache.Schedule),
+			TeamTTL:         cmp.Or(settings.Cache.Team, defaults.Cache.Team),
+			PlayerTTL:       cmp.Or(settings.Cache.Player, defaults.Cache.Player),
+			LeadersTTL:      cmp.Or(settings.Cache.Leaders, defaults.Cache.Leaders),
+			PreviewTTL:      cmp.Or(settings.Cache.Preview, defaults.Cache.Preview),
+			MaxMatchesCache: cmp.Or(settings.Cache.MaxMatches, defaults.Cache.MaxMatches),
+			MaxDetailsCache: cmp.Or(settings.Cache.MaxDetails, defaults.Cache.MaxDetails),
+		},
+	}
+}
+
 // NewClient creates a new NBA API client with default configuration.
 func NewClient() *Client {
+	return NewClientWithConfig(DefaultConfig())
+}
+
+// NewClientWithConfig creates a new NBA API client with the given configuration.
+func NewClientWithConfig(cfg Config) *Client {
 	return &Client{
-		httpClient:  &http.Client{Timeout: 15 * time.Second},
+		httpClient:  &http.Client{Timeout: cfg.Timeout},
 		baseURL:     baseURL,
-		rateLimiter: NewRateLimiter(250 * time.Millisecond),
-		cache:       NewResponseCache(DefaultCacheConfig()),
+		rateLimiter: NewRateLimiter(cfg.RequestInterval),
+		cache:       NewResponseCache(cfg.Cache),
 	}
 }
 
@@ -97,30 +139,8 @@ func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match
 
 	var matches []api.Match
 	for _, g := range resp.Scoreboard.Games {
-		// Map API status to internal status
-		var status api.MatchStatus
-		switch g.GameStatus {
-		case gameStatusScheduled:
-			status = api.MatchStatusNotStarted
-		case gameStatusLive:
-			status = api.MatchStatusLive
-		case gameStatusFinal:
-			status = api.MatchStatusFinished
-		default:
-			status = api.MatchStatusNotStarted
-		}
-
-		// Parse game time
-		var matchTime *time.Time
-		if g.GameTimeUTC != "" {
-			// try RFC3339 first, then "2006-01-02T15:04:05Z"
-			for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z"} {
-				if t, err := time.Parse(layout, g.GameTimeUTC); err == nil {
-					matchTime = &t
-					break
-				}
-			}
-		}
+		status := matchStatus(g.GameStatus)
+		matchTime := parseGameTimeUTC(g.GameTimeUTC)
 
 		// LiveTime: human-readable game time
 		var liveTime *string
@@ -143,16 +163,8 @@ func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match
 		// Teams and scores
 		homeScore := g.HomeTeam.Score
 		awayScore := g.AwayTeam.Score
-		homeTeam := api.Team{
-			ID:        g.HomeTeam.TeamID,
-			Name:      g.HomeTeam.TeamCity + " " + g.HomeTeam.TeamName,
-			ShortName: g.HomeTeam.TeamTricode,
-		}
-		awayTeam := api.Team{
-			ID:        g.AwayTeam.TeamID,
-			Name:      g.AwayTeam.TeamCity + " " + g.AwayTeam.TeamName,
-			ShortName: g.AwayTeam.TeamTricode,
-		}
+		homeTeam := g.HomeTeam.team()
+		awayTeam := g.AwayTeam.team()
 
 		numericID := simpleHash(g.GameID)
 		storeGameID(numericID, g.GameID)
@@ -188,6 +200,7 @@ func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match
 			IsPlayoffs:    isPlayoffGame(g.GameID),
 			SeriesStatus:  seriesStatus,
 			QuarterScores: qScores,
+			Broadcasters:  broadcasterNames(g.Broadcasters),
 		}
 		matches = append(matches, m)
 	}
@@ -312,7 +325,7 @@ func (c *Client) MatchFromCache(matchID int) *api.Match {
 // leagueID: 0 = all teams, 1 = Eastern Conference, 2 = Western Conference.
 // leagueName is ignored for NBA (kept for interface compatibility).
 func (c *Client) LeagueTable(ctx context.Context, leagueID int, _ string) ([]api.LeagueTableEntry, error) {
-	season := currentNBASeason()
+	season := CurrentSeason()
 	url := fmt.Sprintf("%s/leaguestandingsv3?LeagueID=00&Season=%s&SeasonType=Regular+Season", c.baseURL, season)
 
 	var resp standingsResponse
@@ -364,7 +377,7 @@ func (c *Client) LeagueTable(ctx context.Context, leagueID int, _ string) ([]api
 		confRank := standings.colInt(row, "ConferenceRank")
 
 		entry := api.LeagueTableEntry{
-			Team:           api.Team{Name: teamName, ShortName: standings.colStr(row, "TeamAbbreviation")},
+			Team:           api.Team{ID: standings.colInt(row, "TeamID"), Name: teamName, ShortName: standings.colStr(row, "TeamAbbreviation")},
 			Position:       confRank,
 			Played:         total,
 			Won:            wins,
@@ -382,9 +395,53 @@ func (c *Client) LeagueTable(ctx context.Context, leagueID int, _ string) ([]api
 	return entries, nil
 }
 
-// currentNBASeason returns the NBA season string for the current date.
+// broadcasterNames flattens scoreboard TV coverage into display names.
+// National networks come first, followed by the home and away regional feeds.
+func broadcasterNames(b scoreboardV3Broadcasters) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, list := range [][]scoreboardV3Broadcaster{b.NationalBroadcasters, b.HomeTvBroadcasters, b.AwayTvBroadcasters} {
+		for _, br := range list {
+			name := strings.TrimSpace(br.BroadcasterDisplay)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// matchStatus maps an API game status to the internal match status.
+func matchStatus(gameStatus int) api.MatchStatus {
+	switch gameStatus {
+	case gameStatusLive:
+		return api.MatchStatusLive
+	case gameStatusFinal:
+		return api.MatchStatusFinished
+	default:
+		return api.MatchStatusNotStarted
+	}
+}
+
+// parseGameTimeUTC parses an ISO8601 tip-off time, or returns nil.
+func parseGameTimeUTC(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	// try RFC3339 first, then "2006-01-02T15:04:05Z"
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// CurrentSeason returns the NBA season string for the current date.
 // e.g. Feb 2026 → "2025-26"
-func currentNBASeason() string {
+func CurrentSeason() string {
 	now := time.Now()
 	year := now.Year()
 	if now.Month() < 10 {
@@ -613,6 +670,7 @@ func parsePlayerStatsV3(resp boxScoreTraditionalV3Response, homeTeamID int) (hom
 		}
 
 		line := api.PlayerStatLine{
+			PlayerID:  ps.colInt(row, "personId"),
 			Name:      name,
 			Position:  ps.colStr(row, "position"),
 			Minutes:   mins,
@@ -628,6 +686,7 @@ func parsePlayerStatsV3(resp boxScoreTraditionalV3Response, homeTeamID int) (hom
 			FTM:       ps.colInt(row, "freeThrowsMade"),
 			FTA:       ps.colInt(row, "freeThrowsAttempted"),
 			PlusMinus: ps.colInt(row, "plusMinusPoints"),
+			Starter:   ps.colStr(row, "position") != "", // v3 only sets position for starters
 		}
 
 		if teamID == homeTeamID {
@@ -703,13 +762,18 @@ func parsePlayByPlayV3(resp playByPlayV3Response) []api.MatchEvent {
 		}
 
 		if actionType == "Made Shot" || actionType == "field_goal" {
-			isThree := strings.EqualFold(subType, "3pt")
+			isThree := strings.EqualFold(subType, "3pt") || strings.Contains(desc, "3PT")
 			event.IsThree = &isThree
 			pts := 2
 			if isThree {
 				pts = 3
 			}
 			event.Points = &pts
+			// Shot type ("dunk shot", "jump shot"), used to pick highlight plays
+			if subType != "" && !strings.EqualFold(subType, "3pt") {
+				shot := strings.ToLower(subType)
+				event.EventSubtype = &shot
+			}
 		}
 		if actionType == "Free Throw" && strings.Contains(desc, "MADE") {
 			pts := 1
@@ -744,6 +808,8 @@ func actionTypeToEventType(actionType string) string {
 		return "jump_ball"
 	case "Ejection":
 		return "ejection"
+	case "Block":
+		return "block"
 	default:
 		return "other"
 	}
@@ -799,11 +865,15 @@ func isPlayoffGame(gameID string) bool {
 // uses int IDs. We maintain a registry to map between them.
 
 var (
-	gameIDRegistry = make(map[int]string) // numericID → string gameID
+	gameIDRegistryMu sync.RWMutex
+	gameIDRegistry   = make(map[int]string) // numericID → string gameID
 )
 
 // StoreGameID stores the string game ID for later retrieval by numeric ID.
+// Safe for concurrent use: commands fetching scoreboards and schedules run in parallel.
 func StoreGameID(numericID int, stringID string) {
+	gameIDRegistryMu.Lock()
+	defer gameIDRegistryMu.Unlock()
 	gameIDRegistry[numericID] = stringID
 }
 
@@ -814,6 +884,8 @@ func storeGameID(numericID int, stringID string) {
 
 // storedGameID retrieves the string game ID for a numeric ID.
 func storedGameID(numericID int) string {
+	gameIDRegistryMu.RLock()
+	defer gameIDRegistryMu.RUnlock()
 	return gameIDRegistry[numericID]
 }
 
@@ -0,0 +1,161 @@
+package nba
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// Kinds of notable plays, the plays highlight clips are searched for.
+const (
+	PlayGameWinner = "game-winner"
+	PlayMilestone  = "milestone"
+	PlayBigThree   = "three"
+	PlayDunk       = "dunk"
+	PlayBlock      = "block"
+)
+
+// gameWinnerSeconds is how late in the fourth quarter or overtime a go-ahead
+// basket counts as a game-winner.
+const gameWinnerSeconds = 30
+
+// NotablePlay is a play worth a highlight clip.
+type NotablePlay struct {
+	Event     api.MatchEvent
+	Home      bool   // the play is the home team's
+	Kind      string // PlayDunk, PlayBlock...
+	Detail    string // the milestone reached, "40 points"; empty for other kinds
+	Period    int
+	Clock     string // time left in the period, "0:04"
+	HomeScore int    // score after the play
+	AwayScore int
+}
+
+// NotablePlays picks the plays of a game worth a highlight clip from events,
+// the game's plays and any milestone events, newest first: game-winners,
+// the plays that brought a milestone, threes that tie the game or take the
+// lead in the fourth quarter or overtime, dunks and blocks. A play is listed
+// once, as the first of those kinds it is.
+func NotablePlays(details *api.MatchDetails, events []api.MatchEvent) []NotablePlay {
+	if details == nil {
+		return nil
+	}
+	plays := make([]api.MatchEvent, 0, len(events))
+	var milestones []api.MatchEvent
+	for _, ev := range events {
+		if ev.Type == EventTypeMilestone {
+			milestones = append(milestones, ev)
+		} else {
+			plays = append(plays, ev)
+		}
+	}
+	sort.SliceStable(plays, func(i, j int) bool { return plays[i].ID < plays[j].ID })
+
+	// A milestone is timed at the latest play of the poll it was reached in;
+	// its clip is the player's last basket up to then
+	reached := make(map[int]string)
+	for _, ms := range milestones {
+		if ms.Player == nil || ms.EventSubtype == nil {
+			continue
+		}
+		for i := len(plays) - 1; i >= 0; i-- {
+			ev := plays[i]
+			if ev.ID <= ms.PlayID && ev.Points != nil && ev.Player != nil && sameLastName(*ev.Player, *ms.Player) {
+				reached[ev.ID] = *ms.EventSubtype
+				break
+			}
+		}
+	}
+
+	var notable []NotablePlay
+	home, away := 0, 0
+	for _, ev := range plays {
+		before := home - away
+		isHome := isHomeEvent(ev, details)
+		if ev.Points != nil {
+			if isHome {
+				home += *ev.Points
+			} else {
+				away += *ev.Points
+			}
+		}
+		period, clock, ok := ParseGameClock(ev.DisplayMinute)
+		if !ok || ev.Player == nil {
+			continue
+		}
+
+		// Margin of the play's team before and after it
+		marginBefore, marginAfter := before, home-away
+		if !isHome {
+			marginBefore, marginAfter = -marginBefore, -marginAfter
+		}
+		late := period >= 4
+		basket := ev.Type == "field_goal"
+		three := basket && ev.IsThree != nil && *ev.IsThree
+
+		kind, detail := "", ""
+		switch {
+		case basket && late && clockSeconds(clock) <= gameWinnerSeconds && marginBefore <= 0 && marginAfter > 0:
+			kind = PlayGameWinner
+		case reached[ev.ID] != "":
+			kind, detail = PlayMilestone, reached[ev.ID]
+		case three && late && marginBefore <= 0 && marginAfter >= 0:
+			kind = PlayBigThree
+		case basket && ev.EventSubtype != nil && isDunk(*ev.EventSubtype):
+			kind = PlayDunk
+		case ev.Type == "block":
+			kind = PlayBlock
+		default:
+			continue
+		}
+		notable = append(notable, NotablePlay{
+			Event:     ev,
+			Home:      isHome,
+			Kind:      kind,
+			Detail:    detail,
+			Period:    period,
+			Clock:     clock,
+			HomeScore: home,
+			AwayScore: away,
+		})
+	}
+
+	for i, j := 0, len(notable)-1; i < j; i, j = i+1, j-1 {
+		notable[i], notable[j] = notable[j], notable[i]
+	}
+	return notable
+}
+
+// ParseGameClock splits a play's display time, "Q3 4:52", into the period
+// and the time left in it.
+func ParseGameClock(display string) (period int, clock string, ok bool) {
+	var m, s int
+	if _, err := fmt.Sscanf(display, "Q%d %d:%d", &period, &m, &s); err != nil || period < 1 {
+		return 0, "", false
+	}
+	return period, fmt.Sprintf("%d:%02d", m, s), true
+}
+
+// clockSeconds returns the seconds left on a "m:ss" clock.
+func clockSeconds(clock string) int {
+	var m, s int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &m, &s); err != nil {
+		return 0
+	}
+	return m*60 + s
+}
+
+// isDunk reports whether a shot type is a dunk or an alley-oop.
+func isDunk(shot string) bool {
+	shot = strings.ToLower(shot)
+	return strings.Contains(shot, "dunk") || strings.Contains(shot, "alley oop")
+}
+
+// sameLastName reports whether two renderings of a player's name, "J. Tatum"
+// and "Jayson Tatum", share the last name.
+func sameLastName(a, b string) bool {
+	fa, fb := strings.Fields(a), strings.Fields(b)
+	return len(fa) > 0 && len(fb) > 0 && strings.EqualFold(fa[len(fa)-1], fb[len(fb)-1])
+}
@@ -0,0 +1,126 @@
+package nba
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// withSubtype sets the shot type of a play.
+func withSubtype(ev api.MatchEvent, subtype string) api.MatchEvent {
+	ev.EventSubtype = &subtype
+	return ev
+}
+
+// testMilestone returns a milestone event timed at the play playID.
+func testMilestone(id, playID int, player, detail string) api.MatchEvent {
+	return api.MatchEvent{ID: id, PlayID: playID, Type: EventTypeMilestone, Player: &player, EventSubtype: &detail}
+}
+
+func TestParseGameClock(t *testing.T) {
+	tests := []struct {
+		display    string
+		wantPeriod int
+		wantClock  string
+		wantOK     bool
+	}{
+		{"Q1 12:00", 1, "12:00", true},
+		{"Q3 4:52", 3, "4:52", true},
+		{"Q4 0:04", 4, "0:04", true},
+		{"Q5 0:04", 5, "0:04", true},
+		{"Q10 1:05", 10, "1:05", true},
+		{"Q0 1:00", 0, "", false},
+		{"45'", 0, "", false},
+		{"", 0, "", false},
+	}
+	for _, tt := range tests {
+		period, clock, ok := ParseGameClock(tt.display)
+		if period != tt.wantPeriod || clock != tt.wantClock || ok != tt.wantOK {
+			t.Errorf("ParseGameClock(%q) = %d, %q, %v, want %d, %q, %v",
+				tt.display, period, clock, ok, tt.wantPeriod, tt.wantClock, tt.wantOK)
+		}
+	}
+}
+
+func TestNotablePlays(t *testing.T) {
+	type notable struct {
+		id     int
+		kind   string
+		detail string
+	}
+	block := api.MatchEvent{ID: 3, DisplayMinute: "Q2 6:00", Type: "block", Team: api.Team{ID: testAway.ID}}
+	blocker := "B. Adebayo"
+	block.Player = &blocker
+
+	tests := []struct {
+		name   string
+		events []api.MatchEvent
+		want   []notable
+	}{
+		{
+			name: "go-ahead basket at the buzzer",
+			events: []api.MatchEvent{
+				testBasket(1, testHome, "Q1 10:00", "J. Tatum", 2),
+				testBasket(2, testAway, "Q4 1:00", "T. Herro", 3),
+				testBasket(3, testHome, "Q4 0:04", "J. Tatum", 3),
+			},
+			want: []notable{{3, PlayGameWinner, ""}, {2, PlayBigThree, ""}},
+		},
+		{
+			name: "overtime winner after the tying basket",
+			events: []api.MatchEvent{
+				testBasket(1, testAway, "Q4 3:00", "T. Herro", 2),
+				testBasket(2, testHome, "Q5 0:10", "J. Brown", 2),
+				testBasket(3, testHome, "Q5 0:02", "J. Brown", 2),
+			},
+			want: []notable{{3, PlayGameWinner, ""}},
+		},
+		{
+			name: "tying three early is not big",
+			events: []api.MatchEvent{
+				testBasket(1, testAway, "Q2 3:00", "T. Herro", 3),
+				testBasket(2, testHome, "Q2 2:00", "J. Tatum", 3),
+			},
+		},
+		{
+			name: "dunks and blocks, newest first",
+			events: []api.MatchEvent{
+				withSubtype(testBasket(1, testHome, "Q1 8:00", "J. Brown", 2), "Driving Dunk"),
+				withSubtype(testBasket(2, testAway, "Q1 7:00", "B. Adebayo", 2), "Alley Oop Layup"),
+				block,
+				withSubtype(testBasket(4, testHome, "Q2 5:00", "J. Tatum", 2), "Jump Shot"),
+			},
+			want: []notable{{3, PlayBlock, ""}, {2, PlayDunk, ""}, {1, PlayDunk, ""}},
+		},
+		{
+			name: "milestone at the player's last basket before it",
+			events: []api.MatchEvent{
+				testBasket(1, testHome, "Q3 5:00", "J. Tatum", 2),
+				testBasket(2, testAway, "Q3 4:40", "T. Herro", 2),
+				testMilestone(-1, 2, "Jayson Tatum", "30 points"),
+				testBasket(3, testHome, "Q3 4:20", "J. Tatum", 2),
+			},
+			want: []notable{{1, PlayMilestone, "30 points"}},
+		},
+		{
+			name: "plays without a player or clock",
+			events: []api.MatchEvent{
+				{ID: 1, DisplayMinute: "Q1 10:00", Type: "block"},
+				withSubtype(testBasket(2, testHome, "", "J. Brown", 2), "Dunk"),
+			},
+		},
+	}
+	details := &api.MatchDetails{Match: api.Match{HomeTeam: testHome, AwayTeam: testAway}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []notable
+			for _, p := range NotablePlays(details, tt.events) {
+				got = append(got, notable{p.Event.ID, p.Kind, p.Detail})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("NotablePlays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package nba
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// LeaderCategories are the leagueleaders stat categories, in display order.
+var LeaderCategories = []string{"PTS", "REB", "AST", "STL", "BLK", "FG_PCT", "FG3_PCT", "FT_PCT"}
+
+// Leader table modes. Per 36 is not offered by leagueleaders and is derived from totals.
+const (
+	LeadersPerGame = "PerGame"
+	LeadersTotals  = "Totals"
+	LeadersPer36   = "Per36"
+)
+
+// Season types accepted by the stats endpoints.
+const (
+	SeasonTypeRegular  = "Regular Season"
+	SeasonTypePlayoffs = "Playoffs"
+)
+
+// LeadersLimit is the number of players kept per leaders table.
+const LeadersLimit = 50
+
+// LeadersQuery selects a league leaders table.
+type LeadersQuery struct {
+	Category   string // one of LeaderCategories
+	Mode       string // LeadersPerGame, LeadersTotals or LeadersPer36
+	Season     string // "2025-26"
+	SeasonType string // SeasonTypeRegular or SeasonTypePlayoffs
+}
+
+// shootingColumns maps percentage categories to their made/attempted columns.
+var shootingColumns = map[string][2]string{
+	"FG_PCT":  {"FGM", "FGA"},
+	"FG3_PCT": {"FG3M", "FG3A"},
+	"FT_PCT":  {"FTM", "FTA"},
+}
+
+// IsPercentageCategory reports whether a leaders category is a shooting percentage.
+func IsPercentageCategory(category string) bool {
+	_, ok := shootingColumns[category]
+	return ok
+}
+
+// LeagueLeaders returns the top LeadersLimit players for a query, ranked by the category.
+func (c *Client) LeagueLeaders(ctx context.Context, q LeadersQuery) ([]api.LeagueLeader, error) {
+	if cached := c.cache.Leaders(q); cached != nil {
+		return cached, nil
+	}
+
+	perMode := q.Mode
+	if q.Mode == LeadersPer36 {
+		perMode = LeadersTotals
+	}
+	reqURL := fmt.Sprintf("%s/leagueleaders?LeagueID=00&PerMode=%s&Scope=S&Season=%s&SeasonType=%s&StatCategory=%s",
+		c.baseURL, perMode, q.Season, url.QueryEscape(q.SeasonType), q.Category)
+
+	var resp leagueLeadersResponse
+	if err := c.do(ctx, reqURL, &resp); err != nil {
+		return nil, fmt.Errorf("fetch %s leaders: %w", q.Category, err)
+	}
+
+	leaders := parseLeagueLeaders(resp.ResultSet, q.Category)
+	if q.Mode == LeadersPer36 {
+		leaders = per36Leaders(leaders, q.Category)
+	}
+	if len(leaders) > LeadersLimit {
+		leaders = leaders[:LeadersLimit]
+	}
+
+	c.cache.SetLeaders(q, leaders)
+	return leaders, nil
+}
+
+// parseLeagueLeaders converts leagueleaders rows, already ranked by the API.
+func parseLeagueLeaders(rs resultSet, category string) []api.LeagueLeader {
+	cols, shooting := shootingColumns[category]
+
+	leaders := make([]api.LeagueLeader, 0, len(rs.RowSet))
+	for _, row := range rs.RowSet {
+		l := api.LeagueLeader{
+			Rank:     rs.colInt(row, "RANK"),
+			PlayerID: rs.colInt(row, "PLAYER_ID"),
+			Name:     rs.colStr(row, "PLAYER"),
+			Team: api.Team{
+				ID:        rs.colInt(row, "TEAM_ID"),
+				ShortName: rs.colStr(row, "TEAM"),
+			},
+			GamesPlayed: rs.colInt(row, "GP"),
+			Minutes:     rs.colFloat(row, "MIN"),
+			Value:       rs.colFloat(row, category),
+		}
+		if shooting {
+			l.Made = rs.colFloat(row, cols[0])
+			l.Attempted = rs.colFloat(row, cols[1])
+		}
+		leaders = append(leaders, l)
+	}
+	return leaders
+}
+
+// per36Leaders rescales season totals to per-36-minute rates and re-ranks them.
+// Only rotation players qualify (20+ minutes a game in at least half of the
+// games the busiest player has played), otherwise short stints top the table.
+// Percentages do not change; only the made/attempted volume is rescaled.
+func per36Leaders(totals []api.LeagueLeader, category string) []api.LeagueLeader {
+	maxGP := 0
+	for _, l := range totals {
+		maxGP = max(maxGP, l.GamesPlayed)
+	}
+
+	leaders := make([]api.LeagueLeader, 0, len(totals))
+	for _, l := range totals {
+		if l.GamesPlayed == 0 || l.GamesPlayed*2 < maxGP || l.Minutes/float64(l.GamesPlayed) < 20 {
+			continue
+		}
+		scale := 36 / l.Minutes
+		if !IsPercentageCategory(category) {
+			l.Value *= scale
+		}
+		l.Made *= scale
+		l.Attempted *= scale
+		l.Minutes = 36
+		leaders = append(leaders, l)
+	}
+
+	sort.SliceStable(leaders, func(i, j int) bool { return leaders[i].Value > leaders[j].Value })
+	for i := range leaders {
+		leaders[i].Rank = i + 1
+	}
+	return leaders
+}
+
+// PreviousSeason returns the season before season ("2025-26" → "2024-25").
+func PreviousSeason(season string) string {
+	var year int
+	if _, err := fmt.Sscanf(season, "%d-", &year); err != nil {
+		return season
+	}
+	return fmt.Sprintf("%d-%02d", year-1, year%100)
+}
+
+// NextSeason returns the season after season ("2024-25" → "2025-26").
+func NextSeason(season string) string {
+	var year int
+	if _, err := fmt.Sscanf(season, "%d-", &year); err != nil {
+		return season
+	}
+	return fmt.Sprintf("%d-%02d", year+1, (year+2)%100)
+}
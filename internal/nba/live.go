@@ -24,16 +24,25 @@ const (
 	EventPrefixFoul         = "▪" // foul
 	EventPrefixTimeout      = "⏸" // timeout
 	EventPrefixSubstitution = "↔" // substitution
+	EventPrefixMilestone    = "★" // player milestone
 	EventPrefixOther        = "·" // other events
 )
 
 // ParseEvents converts a list of game events into readable update strings.
-// Events are sorted by minute descending (most recent first).
+// Events are sorted by minute, then ID, descending (most recent first); a
+// milestone goes above the play it came with.
 func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTeam api.Team) []string {
 	sorted := make([]api.MatchEvent, len(events))
 	copy(sorted, events)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Minute > sorted[j].Minute
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Minute != b.Minute {
+			return a.Minute > b.Minute
+		}
+		if playOf(a) != playOf(b) {
+			return playOf(a) > playOf(b)
+		}
+		return a.Type == EventTypeMilestone && b.Type != EventTypeMilestone
 	})
 
 	updates := make([]string, 0, len(sorted))
@@ -46,6 +55,15 @@ func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTe
 	return updates
 }
 
+// playOf returns the ID of the play an event is timed at: its own, or for a
+// milestone the play it came with.
+func playOf(ev api.MatchEvent) int {
+	if ev.Type == EventTypeMilestone {
+		return ev.PlayID
+	}
+	return ev.ID
+}
+
 // formatEvent formats a single game event into a readable string.
 func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam api.Team) string {
 	isHome := event.Team.ID == homeTeam.ID
@@ -94,6 +112,16 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 		}
 		return fmt.Sprintf("%s %s [SUB] {OUT}%s {IN}%s %s", EventPrefixSubstitution, event.DisplayMinute, player, playerIn, teamMarker)
 
+	case "block":
+		return fmt.Sprintf("%s %s [BLOCK] %s %s", EventPrefixOther, event.DisplayMinute, player, teamMarker)
+
+	case EventTypeMilestone:
+		detail := ""
+		if event.EventSubtype != nil {
+			detail = *event.EventSubtype
+		}
+		return fmt.Sprintf("%s %s [MILESTONE] %s %s %s", EventPrefixMilestone, event.DisplayMinute, player, detail, teamMarker)
+
 	default:
 		if player != "" {
 			return fmt.Sprintf("%s %s %s %s", EventPrefixOther, event.DisplayMinute, player, teamMarker)
@@ -119,9 +147,6 @@ func (p *LiveUpdateParser) NewEvents(oldEvents, newEvents []api.MatchEvent) []ap
 
 // --- StatsData (mirrors fotmob.StatsData for app/commands.go compatibility) ---
 
-// StatsDataDays is the number of days of data fetched for the stats view.
-const StatsDataDays = 5
-
 // StatsData holds aggregated game data for the stats view.
 type StatsData struct {
 	AllFinished   []api.Match
@@ -0,0 +1,267 @@
+package nba
+
+import (
+	"fmt"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// MilestonePoints are the point totals reported as milestones.
+var MilestonePoints = []int{20, 30, 40, 50}
+
+// MilestoneThrees is the number of threes made reported as a milestone.
+const MilestoneThrees = 10
+
+// EventTypeMilestone is the api.MatchEvent type of milestones added to the
+// live updates by MilestoneWatcher. Milestone events have negative IDs, so
+// they never collide with a play's; PlayID is the play they are timed at.
+const EventTypeMilestone = "milestone"
+
+// Milestone is a statistical milestone a player reached in a game.
+type Milestone struct {
+	Team   api.Team
+	Player string
+	Detail string // "40 points", "double-double", "10 threes", "5x5"
+}
+
+// MilestoneWatch is a player one play away from one or more milestones.
+type MilestoneWatch struct {
+	Team   api.Team
+	Player string
+	Needs  []string // "2 pts for 30 points", "1 reb for a double-double"
+}
+
+// stat is one box score category as the milestones see it.
+type stat struct {
+	unit  string // "pt", "reb"... pluralized with an "s" where it reads right
+	value int
+	play  int // most a single play adds: 3 for points, 1 otherwise
+}
+
+// countingStats returns the five categories of doubles and the 5x5.
+func countingStats(p api.PlayerStatLine) []stat {
+	return []stat{
+		{"pt", p.Points, 3},
+		{"reb", p.Rebounds, 1},
+		{"ast", p.Assists, 1},
+		{"stl", p.Steals, 1},
+		{"blk", p.Blocks, 1},
+	}
+}
+
+// PlayerMilestones returns the milestones a stat line has reached, grouped by
+// kind and in increasing order within each kind.
+func PlayerMilestones(p api.PlayerStatLine) [][]string {
+	var points []string
+	for _, n := range MilestonePoints {
+		if p.Points >= n {
+			points = append(points, fmt.Sprintf("%d points", n))
+		}
+	}
+
+	doubles, fives := 0, 0
+	for _, s := range countingStats(p) {
+		if s.value >= 10 {
+			doubles++
+		}
+		if s.value >= 5 {
+			fives++
+		}
+	}
+	var double []string
+	if doubles >= 2 {
+		double = append(double, "double-double")
+	}
+	if doubles >= 3 {
+		double = append(double, "triple-double")
+	}
+
+	var threes, fiveByFive []string
+	if p.FG3M >= MilestoneThrees {
+		threes = append(threes, fmt.Sprintf("%d threes", MilestoneThrees))
+	}
+	if fives == 5 {
+		fiveByFive = append(fiveByFive, "5x5")
+	}
+
+	return [][]string{points, double, threes, fiveByFive}
+}
+
+// NewMilestones returns the milestones in the box score not yet in seen, and adds them.
+// Only the biggest new milestone of each kind is returned: a player going from
+// 28 to 41 points reports 40 points, not 30 and 40.
+func NewMilestones(details *api.MatchDetails, seen map[string]bool) []Milestone {
+	var found []Milestone
+	for _, side := range boxScoreSides(details) {
+		for _, p := range side.players {
+			key := milestoneKey(p)
+			for _, kind := range PlayerMilestones(p) {
+				var latest string
+				for _, detail := range kind {
+					if !seen[key+":"+detail] {
+						seen[key+":"+detail] = true
+						latest = detail
+					}
+				}
+				if latest != "" {
+					found = append(found, Milestone{Team: side.team, Player: p.Name, Detail: latest})
+				}
+			}
+		}
+	}
+	return found
+}
+
+// WatchMilestones returns the players one play away from a milestone they
+// have not reached: a basket from a points total, or one rebound, assist,
+// steal, block or three from the rest.
+func WatchMilestones(details *api.MatchDetails) []MilestoneWatch {
+	var watch []MilestoneWatch
+	for _, side := range boxScoreSides(details) {
+		for _, p := range side.players {
+			if needs := milestoneNeeds(p); len(needs) > 0 {
+				watch = append(watch, MilestoneWatch{Team: side.team, Player: p.Name, Needs: needs})
+			}
+		}
+	}
+	return watch
+}
+
+// milestoneNeeds describes what a player is missing for each milestone within one play.
+func milestoneNeeds(p api.PlayerStatLine) []string {
+	var needs []string
+
+	for _, n := range MilestonePoints {
+		if p.Points < n {
+			if gap := n - p.Points; gap <= 3 {
+				needs = append(needs, fmt.Sprintf("%s for %d points", amount(gap, "pt"), n))
+			}
+			break
+		}
+	}
+
+	stats := countingStats(p)
+	doubles, fives := 0, 0
+	for _, s := range stats {
+		if s.value >= 10 {
+			doubles++
+		}
+		if s.value >= 5 {
+			fives++
+		}
+	}
+	if doubles == 1 || doubles == 2 {
+		label := "a double-double"
+		if doubles == 2 {
+			label = "a triple-double"
+		}
+		if s, ok := closest(stats, 10); ok {
+			needs = append(needs, fmt.Sprintf("%s for %s", amount(10-s.value, s.unit), label))
+		}
+	}
+	if fives == 4 {
+		if s, ok := closest(stats, 5); ok {
+			needs = append(needs, fmt.Sprintf("%s for a 5x5", amount(5-s.value, s.unit)))
+		}
+	}
+
+	if p.FG3M == MilestoneThrees-1 {
+		needs = append(needs, fmt.Sprintf("1 three for %d threes", MilestoneThrees))
+	}
+	return needs
+}
+
+// closest returns the stat below target that is nearest to it, if a single
+// play can get it there.
+func closest(stats []stat, target int) (stat, bool) {
+	var best stat
+	found := false
+	for _, s := range stats {
+		if s.value >= target || target-s.value > s.play {
+			continue
+		}
+		if !found || target-s.value < target-best.value {
+			best, found = s, true
+		}
+	}
+	return best, found
+}
+
+// amount renders "1 reb", "2 pts".
+func amount(n int, unit string) string {
+	if n > 1 && unit == "pt" {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s", n, unit)
+}
+
+// MilestoneWatcher turns the milestones players reach between polls of the
+// live game into events for the live updates list, timed at the latest play.
+type MilestoneWatcher struct {
+	matchID int
+	seen    map[string]bool
+	events  []api.MatchEvent
+}
+
+// NewMilestoneWatcher creates a watcher with no game.
+func NewMilestoneWatcher() *MilestoneWatcher {
+	return &MilestoneWatcher{}
+}
+
+// Update records the milestones reached since the previous update and
+// returns every milestone event of the game so far. The first update of a
+// game only takes note of the milestones already reached, since when they
+// happened is unknown.
+func (w *MilestoneWatcher) Update(details *api.MatchDetails) []api.MatchEvent {
+	if details == nil {
+		return nil
+	}
+	if w.seen == nil || details.ID != w.matchID {
+		w.matchID, w.seen, w.events = details.ID, make(map[string]bool), nil
+		NewMilestones(details, w.seen)
+		return nil
+	}
+
+	var last api.MatchEvent
+	for _, ev := range details.Events {
+		if ev.ID >= last.ID {
+			last = ev
+		}
+	}
+	for _, ms := range NewMilestones(details, w.seen) {
+		player, detail := ms.Player, ms.Detail
+		w.events = append(w.events, api.MatchEvent{
+			ID:            -(len(w.events) + 1),
+			PlayID:        last.ID,
+			Minute:        last.Minute,
+			DisplayMinute: last.DisplayMinute,
+			Type:          EventTypeMilestone,
+			Team:          ms.Team,
+			Player:        &player,
+			EventSubtype:  &detail,
+		})
+	}
+	return w.events
+}
+
+// boxScoreSide is one team's box score.
+type boxScoreSide struct {
+	team    api.Team
+	players []api.PlayerStatLine
+}
+
+// boxScoreSides returns the home and away box scores.
+func boxScoreSides(details *api.MatchDetails) []boxScoreSide {
+	return []boxScoreSide{
+		{details.HomeTeam, details.HomePlayerStats},
+		{details.AwayTeam, details.AwayPlayerStats},
+	}
+}
+
+// milestoneKey identifies a player within a game.
+func milestoneKey(p api.PlayerStatLine) string {
+	if p.PlayerID != 0 {
+		return fmt.Sprint(p.PlayerID)
+	}
+	return p.Name
+}
@@ -0,0 +1,113 @@
+package nba
+
+import (
+	"testing"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+var (
+	testHome = api.Team{ID: 1, ShortName: "BOS"}
+	testAway = api.Team{ID: 2, ShortName: "MIA"}
+)
+
+// testBasket returns a field goal of team; threes are marked as such.
+func testBasket(id int, team api.Team, display, player string, points int) api.MatchEvent {
+	three := points == 3
+	return api.MatchEvent{
+		ID:            id,
+		DisplayMinute: display,
+		Type:          "field_goal",
+		Team:          api.Team{ID: team.ID},
+		Player:        &player,
+		Points:        &points,
+		IsThree:       &three,
+	}
+}
+
+// testBoxScore returns a snapshot of game id where the home team's J. Tatum
+// has points, with the given plays.
+func testBoxScore(id, points int, plays ...api.MatchEvent) *api.MatchDetails {
+	details := &api.MatchDetails{Match: api.Match{ID: id, HomeTeam: testHome, AwayTeam: testAway}}
+	details.HomePlayerStats = []api.PlayerStatLine{{PlayerID: 7, Name: "Jayson Tatum", Points: points, Rebounds: 9}}
+	details.Events = plays
+	return details
+}
+
+func TestMilestoneWatcherUpdate(t *testing.T) {
+	early := testBasket(10, testHome, "Q3 6:00", "J. Tatum", 2)
+	late := testBasket(12, testHome, "Q3 5:31", "J. Tatum", 3)
+	tests := []struct {
+		name    string
+		updates []*api.MatchDetails
+		want    []string // details of the events returned by the last update
+	}{
+		{
+			name:    "first update is a baseline",
+			updates: []*api.MatchDetails{testBoxScore(1, 31, early)},
+		},
+		{
+			name:    "nothing new",
+			updates: []*api.MatchDetails{testBoxScore(1, 21, early), testBoxScore(1, 27, early, late)},
+		},
+		{
+			name:    "points milestone",
+			updates: []*api.MatchDetails{testBoxScore(1, 28, early), testBoxScore(1, 31, early, late)},
+			want:    []string{"30 points"},
+		},
+		{
+			name:    "biggest milestone only",
+			updates: []*api.MatchDetails{testBoxScore(1, 19, early), testBoxScore(1, 41, early, late)},
+			want:    []string{"40 points"},
+		},
+		{
+			name: "events are kept",
+			updates: []*api.MatchDetails{
+				testBoxScore(1, 18, early), testBoxScore(1, 21, early), testBoxScore(1, 30, early, late), testBoxScore(1, 30, early, late),
+			},
+			want: []string{"20 points", "30 points"},
+		},
+		{
+			name:    "new game starts over",
+			updates: []*api.MatchDetails{testBoxScore(1, 18, early), testBoxScore(1, 21, early), testBoxScore(2, 31, early)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewMilestoneWatcher()
+			var got []api.MatchEvent
+			for _, details := range tt.updates {
+				got = w.Update(details)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Update() = %d events, want %v", len(got), tt.want)
+			}
+			for i, ev := range got {
+				if ev.Type != EventTypeMilestone || ev.EventSubtype == nil || *ev.EventSubtype != tt.want[i] {
+					t.Errorf("event %d = %+v, want a %q milestone", i, ev, tt.want[i])
+				}
+				if ev.ID != -(i + 1) {
+					t.Errorf("event %d ID = %d, want %d", i, ev.ID, -(i + 1))
+				}
+				if ev.Player == nil || *ev.Player != "Jayson Tatum" || ev.Team.ID != testHome.ID {
+					t.Errorf("event %d player = %v of team %d, want Jayson Tatum of the home team", i, ev.Player, ev.Team.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestMilestoneWatcherTiming(t *testing.T) {
+	early := testBasket(10, testHome, "Q3 6:00", "J. Tatum", 2)
+	late := testBasket(12, testHome, "Q3 5:31", "J. Tatum", 3)
+	w := NewMilestoneWatcher()
+	w.Update(testBoxScore(1, 28, early))
+	// Plays may arrive out of order; the milestone is timed at the latest
+	got := w.Update(testBoxScore(1, 31, late, early))
+	if len(got) != 1 {
+		t.Fatalf("Update() = %v, want one milestone", got)
+	}
+	if ev := got[0]; ev.PlayID != late.ID || ev.DisplayMinute != late.DisplayMinute {
+		t.Errorf("milestone timed at play %d (%s), want %d (%s)", ev.PlayID, ev.DisplayMinute, late.ID, late.DisplayMinute)
+	}
+}
@@ -0,0 +1,201 @@
+package nba
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// PlayerProfile returns a player's bio, current-season per-game averages and game log.
+// playoffs selects the playoff game log instead of the regular season one, so the
+// game being watched appears in it. Bio is required; averages and log are best-effort.
+func (c *Client) PlayerProfile(ctx context.Context, playerID int, playoffs bool) (*api.PlayerProfile, error) {
+	if cached := c.cache.PlayerProfile(playerID, playoffs); cached != nil {
+		return cached, nil
+	}
+
+	profile, err := c.playerInfo(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	season := CurrentSeason()
+	profile.Season = season
+
+	if averages, err := c.playerSeasonAverages(ctx, playerID, season, playoffs); err == nil {
+		profile.Averages = averages
+	}
+	if log, err := c.playerGameLog(ctx, playerID, season, playoffs); err == nil {
+		profile.GameLog = log
+	}
+
+	c.cache.SetPlayerProfile(playerID, playoffs, profile)
+	return profile, nil
+}
+
+// playerInfo fetches bio basics via commonplayerinfo.
+func (c *Client) playerInfo(ctx context.Context, playerID int) (*api.PlayerProfile, error) {
+	url := fmt.Sprintf("%s/commonplayerinfo?LeagueID=00&PlayerID=%d", c.baseURL, playerID)
+
+	var resp commonPlayerInfoResponse
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch player info for %d: %w", playerID, err)
+	}
+
+	rs := findResultSet(resp.ResultSets, "CommonPlayerInfo")
+	if len(rs.RowSet) == 0 {
+		return nil, fmt.Errorf("player %d not found", playerID)
+	}
+	row := rs.RowSet[0]
+
+	profile := &api.PlayerProfile{
+		ID:   playerID,
+		Name: rs.colStr(row, "DISPLAY_FIRST_LAST"),
+		Team: api.Team{
+			ID:        rs.colInt(row, "TEAM_ID"),
+			Name:      strings.TrimSpace(rs.colStr(row, "TEAM_CITY") + " " + rs.colStr(row, "TEAM_NAME")),
+			ShortName: rs.colStr(row, "TEAM_ABBREVIATION"),
+		},
+		Position:   rs.colStr(row, "POSITION"),
+		Jersey:     rs.colStr(row, "JERSEY"),
+		Height:     rs.colStr(row, "HEIGHT"),
+		Weight:     rs.colStr(row, "WEIGHT"),
+		Country:    rs.colStr(row, "COUNTRY"),
+		School:     rs.colStr(row, "SCHOOL"),
+		Experience: rs.colInt(row, "SEASON_EXP"),
+		Draft:      formatDraft(rs.colStr(row, "DRAFT_YEAR"), rs.colStr(row, "DRAFT_ROUND"), rs.colStr(row, "DRAFT_NUMBER")),
+	}
+
+	// BIRTHDATE is "1998-03-03T00:00:00"
+	if birth, err := time.Parse("2006-01-02T15:04:05", rs.colStr(row, "BIRTHDATE")); err == nil {
+		profile.Age = ageOn(birth, time.Now())
+	}
+
+	return profile, nil
+}
+
+// playerSeasonAverages fetches per-game averages for season via playercareerstats.
+// Players traded mid-season have one row per team plus a "TOT" row; the total wins.
+func (c *Client) playerSeasonAverages(ctx context.Context, playerID int, season string, playoffs bool) (*api.PlayerSeasonAverages, error) {
+	url := fmt.Sprintf("%s/playercareerstats?LeagueID=00&PerMode=PerGame&PlayerID=%d", c.baseURL, playerID)
+
+	var resp playerCareerStatsResponse
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch career stats for %d: %w", playerID, err)
+	}
+
+	setName := "SeasonTotalsRegularSeason"
+	if playoffs {
+		setName = "SeasonTotalsPostSeason"
+	}
+	rs := findResultSet(resp.ResultSets, setName)
+
+	var match []interface{}
+	for _, row := range rs.RowSet {
+		if rs.colStr(row, "SEASON_ID") != season {
+			continue
+		}
+		if match == nil || rs.colStr(row, "TEAM_ABBREVIATION") == "TOT" {
+			match = row
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("no %s averages for player %d", season, playerID)
+	}
+
+	return &api.PlayerSeasonAverages{
+		GamesPlayed:  rs.colInt(match, "GP"),
+		GamesStarted: rs.colInt(match, "GS"),
+		Minutes:      rs.colFloat(match, "MIN"),
+		Points:       rs.colFloat(match, "PTS"),
+		Rebounds:     rs.colFloat(match, "REB"),
+		Assists:      rs.colFloat(match, "AST"),
+		Steals:       rs.colFloat(match, "STL"),
+		Blocks:       rs.colFloat(match, "BLK"),
+		Turnovers:    rs.colFloat(match, "TOV"),
+		FGM:          rs.colFloat(match, "FGM"),
+		FGA:          rs.colFloat(match, "FGA"),
+		FGPct:        rs.colFloat(match, "FG_PCT"),
+		FG3M:         rs.colFloat(match, "FG3M"),
+		FG3A:         rs.colFloat(match, "FG3A"),
+		FG3Pct:       rs.colFloat(match, "FG3_PCT"),
+		FTM:          rs.colFloat(match, "FTM"),
+		FTA:          rs.colFloat(match, "FTA"),
+		FTPct:        rs.colFloat(match, "FT_PCT"),
+	}, nil
+}
+
+// playerGameLog fetches the season game log via playergamelog, most recent first.
+func (c *Client) playerGameLog(ctx context.Context, playerID int, season string, playoffs bool) ([]api.PlayerGameLogEntry, error) {
+	seasonType := "Regular+Season"
+	if playoffs {
+		seasonType = "Playoffs"
+	}
+	url := fmt.Sprintf("%s/playergamelog?LeagueID=00&PlayerID=%d&Season=%s&SeasonType=%s", c.baseURL, playerID, season, seasonType)
+
+	var resp playerGameLogResponse
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch game log for %d: %w", playerID, err)
+	}
+
+	rs := findResultSet(resp.ResultSets, "PlayerGameLog")
+	log := make([]api.PlayerGameLogEntry, 0, len(rs.RowSet))
+	for _, row := range rs.RowSet {
+		gameID := rs.colStr(row, "Game_ID")
+		entry := api.PlayerGameLogEntry{
+			MatchID:   simpleHash(gameID),
+			Matchup:   rs.colStr(row, "MATCHUP"),
+			Result:    rs.colStr(row, "WL"),
+			Minutes:   rs.colInt(row, "MIN"),
+			Points:    rs.colInt(row, "PTS"),
+			Rebounds:  rs.colInt(row, "REB"),
+			Assists:   rs.colInt(row, "AST"),
+			FGM:       rs.colInt(row, "FGM"),
+			FGA:       rs.colInt(row, "FGA"),
+			FG3M:      rs.colInt(row, "FG3M"),
+			FG3A:      rs.colInt(row, "FG3A"),
+			FTM:       rs.colInt(row, "FTM"),
+			FTA:       rs.colInt(row, "FTA"),
+			PlusMinus: rs.colInt(row, "PLUS_MINUS"),
+		}
+		// GAME_DATE is "OCT 22, 2025"
+		if t, err := time.Parse("Jan 02, 2006", titleMonth(rs.colStr(row, "GAME_DATE"))); err == nil {
+			entry.Date = t
+		}
+		storeGameID(entry.MatchID, gameID)
+		log = append(log, entry)
+	}
+	return log, nil
+}
+
+// formatDraft renders draft info as "2017 R1 #3", or "Undrafted".
+func formatDraft(year, round, number string) string {
+	if year == "" || strings.EqualFold(year, "Undrafted") {
+		return "Undrafted"
+	}
+	if round == "" || strings.EqualFold(round, "Undrafted") {
+		return year
+	}
+	return fmt.Sprintf("%s R%s #%s", year, round, number)
+}
+
+// ageOn returns the age in whole years of someone born on birth at time now.
+func ageOn(birth, now time.Time) int {
+	age := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || (now.Month() == birth.Month() && now.Day() < birth.Day()) {
+		age--
+	}
+	return age
+}
+
+// titleMonth converts an upper-case month abbreviation ("OCT 22, 2025") to the
+// form time.Parse expects ("Oct 22, 2025").
+func titleMonth(s string) string {
+	if len(s) < 3 {
+		return s
+	}
+	return s[:1] + strings.ToLower(s[1:3]) + s[3:]
+}
@@ -0,0 +1,176 @@
+package nba
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// previewTopScorers is the number of top scorers listed per team.
+const previewTopScorers = 3
+
+// GamePreview builds the pre-game preview for a scheduled game.
+// Records, seeds and streaks come from the standings; last five results and the
+// season series from the league schedule; projected starters are each team's
+// starting five in its previous game; top scorers come from season averages.
+// Every part is best-effort, but the preview fails if neither standings nor schedule load.
+func (c *Client) GamePreview(ctx context.Context, match api.Match) (*api.GamePreview, error) {
+	if cached := c.cache.Preview(match.ID); cached != nil {
+		return cached, nil
+	}
+
+	season := CurrentSeason()
+	preview := &api.GamePreview{
+		MatchID: match.ID,
+		Home:    api.PreviewTeam{Team: match.HomeTeam},
+		Away:    api.PreviewTeam{Team: match.AwayTeam},
+	}
+	sides := []*api.PreviewTeam{&preview.Home, &preview.Away}
+
+	standings, standingsErr := c.LeagueTable(ctx, 0, "")
+	if standingsErr == nil {
+		for _, side := range sides {
+			applyStandings(side, standings)
+		}
+	}
+
+	schedule, scheduleErr := c.SeasonSchedule(ctx, season)
+	if scheduleErr == nil {
+		for _, side := range sides {
+			side.LastFive = lastResults(schedule, side.Team.ID, 5)
+			if len(side.LastFive) > 0 {
+				if starters, err := c.startingFive(ctx, side.LastFive[0], side.Team.ID); err == nil {
+					side.Starters = starters
+				}
+			}
+		}
+		preview.SeasonSeries = seasonSeries(schedule, match.HomeTeam.ID, match.AwayTeam.ID)
+	}
+
+	if standingsErr != nil && scheduleErr != nil {
+		return nil, fmt.Errorf("preview for game %d: %w", match.ID, errors.Join(standingsErr, scheduleErr))
+	}
+
+	for _, side := range sides {
+		if scorers, err := c.teamTopScorers(ctx, side.Team.ID, season); err == nil {
+			side.TopScorers = scorers
+		}
+	}
+
+	c.cache.SetPreview(match.ID, preview)
+	return preview, nil
+}
+
+// applyStandings fills a preview side's record, seed and streak from the standings.
+func applyStandings(side *api.PreviewTeam, standings []api.LeagueTableEntry) {
+	for _, e := range standings {
+		if e.Team.ID != side.Team.ID {
+			continue
+		}
+		side.Wins, side.Losses = e.Won, e.Lost
+		side.ConferenceRank = e.Position
+		side.Streak = strings.ReplaceAll(e.Form, " ", "")
+		// Note is "East | GB: 3.5"
+		side.Conference, _, _ = strings.Cut(e.Note, " | ")
+		return
+	}
+}
+
+// lastResults returns a team's last n finished games, most recent first.
+func lastResults(schedule []api.Match, teamID, n int) []api.Match {
+	var results []api.Match
+	for i := len(schedule) - 1; i >= 0 && len(results) < n; i-- {
+		m := schedule[i]
+		if m.Status != api.MatchStatusFinished {
+			continue
+		}
+		if m.HomeTeam.ID == teamID || m.AwayTeam.ID == teamID {
+			results = append(results, m)
+		}
+	}
+	return results
+}
+
+// seasonSeries returns every meeting of two teams in the schedule, in date order.
+func seasonSeries(schedule []api.Match, teamA, teamB int) []api.Match {
+	var series []api.Match
+	for _, m := range schedule {
+		if (m.HomeTeam.ID == teamA && m.AwayTeam.ID == teamB) || (m.HomeTeam.ID == teamB && m.AwayTeam.ID == teamA) {
+			series = append(series, m)
+		}
+	}
+	return series
+}
+
+// startingFive returns a team's starters in a finished game via boxscoretraditionalv3,
+// guards first, then forwards and center.
+func (c *Client) startingFive(ctx context.Context, game api.Match, teamID int) ([]string, error) {
+	gameID := storedGameID(game.ID)
+	if gameID == "" {
+		return nil, fmt.Errorf("game ID not found for match %d", game.ID)
+	}
+
+	url := fmt.Sprintf("%s/boxscoretraditionalv3?GameID=%s&StartPeriod=1&EndPeriod=10&StartRange=0&EndRange=28800&RangeType=0", c.baseURL, gameID)
+	var resp boxScoreTraditionalV3Response
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch box score for game %s: %w", gameID, err)
+	}
+
+	home, away := parsePlayerStatsV3(resp, game.HomeTeam.ID)
+	players := home
+	if game.AwayTeam.ID == teamID {
+		players = away
+	}
+
+	var starters []api.PlayerStatLine
+	for _, p := range players {
+		if p.Starter {
+			starters = append(starters, p)
+		}
+	}
+	if len(starters) == 0 {
+		return nil, fmt.Errorf("no starters in game %s", gameID)
+	}
+
+	order := map[string]int{"G": 0, "F": 1, "C": 2}
+	sort.SliceStable(starters, func(i, j int) bool { return order[starters[i].Position] < order[starters[j].Position] })
+
+	names := make([]string, 0, len(starters))
+	for _, p := range starters {
+		names = append(names, p.Name)
+	}
+	return names, nil
+}
+
+// teamTopScorers returns a team's leading scorers by points per game via leaguedashplayerstats.
+func (c *Client) teamTopScorers(ctx context.Context, teamID int, season string) ([]api.PreviewScorer, error) {
+	params := append(leagueDashParams(season, teamID),
+		"College=", "Country=", "DraftPick=", "DraftYear=", "Height=", "Weight=")
+	url := fmt.Sprintf("%s/leaguedashplayerstats?%s", c.baseURL, strings.Join(params, "&"))
+
+	var resp leagueDashPlayerStatsResponse
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch player stats for team %d: %w", teamID, err)
+	}
+
+	rs := findResultSet(resp.ResultSets, "LeagueDashPlayerStats")
+	scorers := make([]api.PreviewScorer, 0, len(rs.RowSet))
+	for _, row := range rs.RowSet {
+		scorers = append(scorers, api.PreviewScorer{
+			PlayerID: rs.colInt(row, "PLAYER_ID"),
+			Name:     rs.colStr(row, "PLAYER_NAME"),
+			Points:   rs.colFloat(row, "PTS"),
+			Rebounds: rs.colFloat(row, "REB"),
+			Assists:  rs.colFloat(row, "AST"),
+		})
+	}
+	sort.SliceStable(scorers, func(i, j int) bool { return scorers[i].Points > scorers[j].Points })
+	if len(scorers) > previewTopScorers {
+		scorers = scorers[:previewTopScorers]
+	}
+	return scorers, nil
+}
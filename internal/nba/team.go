@@ -0,0 +1,241 @@
+package nba
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+// SeasonSchedule returns every game of the given season ("2025-26"), sorted by tip-off.
+// Preseason and All-Star games are skipped. The response is large, so it is cached
+// for ResponseCache.ScheduleTTL and shared by all team pages.
+func (c *Client) SeasonSchedule(ctx context.Context, season string) ([]api.Match, error) {
+	if cached := c.cache.SeasonSchedule(season); cached != nil {
+		return cached, nil
+	}
+
+	url := fmt.Sprintf("%s/scheduleleaguev2?LeagueID=00&Season=%s", c.baseURL, season)
+
+	var resp scheduleLeagueV2Response
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch schedule for %s: %w", season, err)
+	}
+
+	var matches []api.Match
+	for _, day := range resp.LeagueSchedule.GameDates {
+		for _, g := range day.Games {
+			if !isCompetitiveGame(g.GameID) {
+				continue
+			}
+			matches = append(matches, scheduleGameToMatch(g))
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].MatchTime == nil || matches[j].MatchTime == nil {
+			return matches[i].MatchTime != nil
+		}
+		return matches[i].MatchTime.Before(*matches[j].MatchTime)
+	})
+
+	c.cache.SetSeasonSchedule(season, matches)
+	return matches, nil
+}
+
+// TeamProfile returns the current-season page for a team: schedule with results,
+// roster and per-game averages with league ranks.
+// The schedule is required; roster and averages are best-effort and left empty on error.
+func (c *Client) TeamProfile(ctx context.Context, teamID int) (*api.TeamProfile, error) {
+	if cached := c.cache.TeamProfile(teamID); cached != nil {
+		return cached, nil
+	}
+
+	season := CurrentSeason()
+	all, err := c.SeasonSchedule(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &api.TeamProfile{Season: season}
+	for _, m := range all {
+		switch teamID {
+		case m.HomeTeam.ID:
+			profile.Team = m.HomeTeam
+		case m.AwayTeam.ID:
+			profile.Team = m.AwayTeam
+		default:
+			continue
+		}
+		profile.Schedule = append(profile.Schedule, m)
+	}
+	if len(profile.Schedule) == 0 {
+		return nil, fmt.Errorf("no %s games found for team %d", season, teamID)
+	}
+	profile.Wins, profile.Losses = teamRecord(profile.Schedule, teamID)
+
+	if roster, err := c.teamRoster(ctx, teamID, season); err == nil {
+		profile.Roster = roster
+	}
+	if averages, wins, losses, err := c.teamAverages(ctx, teamID, season); err == nil {
+		profile.Averages = averages
+		// Dashboard record covers the regular season only, which is what fans quote
+		if wins+losses > 0 {
+			profile.Wins, profile.Losses = wins, losses
+		}
+	}
+
+	c.cache.SetTeamProfile(teamID, profile)
+	return profile, nil
+}
+
+// teamRoster fetches the current roster via commonteamroster.
+func (c *Client) teamRoster(ctx context.Context, teamID int, season string) ([]api.RosterPlayer, error) {
+	url := fmt.Sprintf("%s/commonteamroster?LeagueID=00&Season=%s&TeamID=%d", c.baseURL, season, teamID)
+
+	var resp commonTeamRosterResponse
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, fmt.Errorf("fetch roster for team %d: %w", teamID, err)
+	}
+
+	rs := findResultSet(resp.ResultSets, "CommonTeamRoster")
+	roster := make([]api.RosterPlayer, 0, len(rs.RowSet))
+	for _, row := range rs.RowSet {
+		roster = append(roster, api.RosterPlayer{
+			ID:         rs.colInt(row, "PLAYER_ID"),
+			Name:       rs.colStr(row, "PLAYER"),
+			Number:     rs.colStr(row, "NUM"),
+			Position:   rs.colStr(row, "POSITION"),
+			Height:     rs.colStr(row, "HEIGHT"),
+			Weight:     rs.colStr(row, "WEIGHT"),
+			Age:        int(rs.colFloat(row, "AGE")),
+			Experience: rs.colStr(row, "EXP"),
+			School:     rs.colStr(row, "SCHOOL"),
+		})
+	}
+	return roster, nil
+}
+
+// teamAverageStats lists the leaguedashteamstats columns shown on the team page.
+// Each column has a matching <COLUMN>_RANK column with the league rank.
+var teamAverageStats = []struct {
+	column string
+	key    string
+	label  string
+}{
+	{"PTS", "pts", "Points"},
+	{"REB", "reb", "Rebounds"},
+	{"AST", "ast", "Assists"},
+	{"STL", "stl", "Steals"},
+	{"BLK", "blk", "Blocks"},
+	{"TOV", "tov", "Turnovers"},
+	{"OREB", "oreb", "Off. Rebounds"},
+	{"FG_PCT", "fg_pct", "FG%"},
+	{"FG3_PCT", "fg3_pct", "3P%"},
+	{"FT_PCT", "ft_pct", "FT%"},
+	{"PLUS_MINUS", "plus_minus", "+/-"},
+}
+
+// leagueDashParams returns the per-game filter parameters shared by the leaguedash*
+// endpoints. They reject requests that omit any filter, so every one is sent.
+// teamID 0 returns every team.
+func leagueDashParams(season string, teamID int) []string {
+	return []string{
+		"Conference=", "DateFrom=", "DateTo=", "Division=", "GameScope=", "GameSegment=",
+		"LastNGames=0", "LeagueID=00", "Location=", "MeasureType=Base", "Month=0",
+		"OpponentTeamID=0", "Outcome=", "PORound=0", "PaceAdjust=N", "PerMode=PerGame",
+		"Period=0", "PlayerExperience=", "PlayerPosition=", "PlusMinus=N", "Rank=N",
+		"Season=" + season, "SeasonSegment=", "SeasonType=Regular+Season", "ShotClockRange=",
+		"StarterBench=", fmt.Sprintf("TeamID=%d", teamID), "TwoWay=0", "VsConference=", "VsDivision=",
+	}
+}
+
+// teamAverages fetches per-game averages and league ranks via leaguedashteamstats.
+func (c *Client) teamAverages(ctx context.Context, teamID int, season string) (stats []api.TeamStatRank, wins, losses int, err error) {
+	url := fmt.Sprintf("%s/leaguedashteamstats?%s", c.baseURL, strings.Join(leagueDashParams(season, 0), "&"))
+
+	var resp leagueDashTeamStatsResponse
+	if err := c.do(ctx, url, &resp); err != nil {
+		return nil, 0, 0, fmt.Errorf("fetch team stats for %s: %w", season, err)
+	}
+
+	rs := findResultSet(resp.ResultSets, "LeagueDashTeamStats")
+	for _, row := range rs.RowSet {
+		if rs.colInt(row, "TEAM_ID") != teamID {
+			continue
+		}
+		for _, s := range teamAverageStats {
+			stats = append(stats, api.TeamStatRank{
+				Key:   s.key,
+				Label: s.label,
+				Value: rs.colFloat(row, s.column),
+				Rank:  rs.colInt(row, s.column+"_RANK"),
+			})
+		}
+		return stats, rs.colInt(row, "W"), rs.colInt(row, "L"), nil
+	}
+	return nil, 0, 0, fmt.Errorf("team %d not found in %s team stats", teamID, season)
+}
+
+// scheduleGameToMatch converts a scheduleleaguev2 game to an api.Match.
+// Unplayed games carry no score.
+func scheduleGameToMatch(g scheduleV2Game) api.Match {
+	numericID := simpleHash(g.GameID)
+	storeGameID(numericID, g.GameID)
+
+	m := api.Match{
+		ID:           numericID,
+		League:       api.League{Name: "NBA"},
+		MatchTime:    parseGameTimeUTC(g.GameDateTimeUTC),
+		Status:       matchStatus(g.GameStatus),
+		HomeTeam:     g.HomeTeam.team(),
+		AwayTeam:     g.AwayTeam.team(),
+		IsPlayoffs:   isPlayoffGame(g.GameID),
+		Broadcasters: broadcasterNames(g.Broadcasters),
+	}
+	if g.GameStatusText != "" {
+		s := strings.TrimSpace(g.GameStatusText)
+		m.LiveTime = &s
+	}
+	if g.SeriesText != "" {
+		s := g.SeriesText
+		m.SeriesStatus = &s
+	}
+	if m.Status != api.MatchStatusNotStarted {
+		home, away := g.HomeTeam.Score, g.AwayTeam.Score
+		m.HomeScore = &home
+		m.AwayScore = &away
+	}
+	return m
+}
+
+// isCompetitiveGame reports whether a game counts toward the season
+// (regular season, NBA Cup, play-in or playoffs). Game type is the third digit:
+// 1 = preseason, 3 = All-Star.
+func isCompetitiveGame(gameID string) bool {
+	if len(gameID) < 3 {
+		return false
+	}
+	return gameID[2] != '1' && gameID[2] != '3'
+}
+
+// teamRecord counts wins and losses in finished games of a team's schedule.
+func teamRecord(games []api.Match, teamID int) (wins, losses int) {
+	for _, m := range games {
+		if m.Status != api.MatchStatusFinished || m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		own, opp := *m.HomeScore, *m.AwayScore
+		if m.AwayTeam.ID == teamID {
+			own, opp = opp, own
+		}
+		if own > opp {
+			wins++
+		} else {
+			losses++
+		}
+	}
+	return wins, losses
+}
@@ -6,6 +6,8 @@ package nba
 import (
 	"fmt"
 	"strconv"
+
+	"github.com/gabriel7419/courtside/internal/api"
 )
 
 // The NBA Stats API returns data in a tabular format: each resultSet has
@@ -126,17 +128,31 @@ type scoreboardV3Scoreboard struct {
 }
 
 type scoreboardV3Game struct {
-	GameID           string           `json:"gameId"`
-	GameCode         string           `json:"gameCode"`
-	GameStatus       int              `json:"gameStatus"`     // 1=scheduled, 2=live, 3=final
-	GameStatusText   string           `json:"gameStatusText"` // "Final", "Q3 2:34"
-	Period           int              `json:"period"`         // current quarter
-	GameClock        string           `json:"gameClock"`      // "PT02M34.00S" or ""
-	GameTimeUTC      string           `json:"gameTimeUTC"`    // ISO8601
-	SeriesGameNumber string           `json:"seriesGameNumber,omitempty"`
-	SeriesText       string           `json:"seriesText,omitempty"` // "Celtics lead 2-1"
-	HomeTeam         scoreboardV3Team `json:"homeTeam"`
-	AwayTeam         scoreboardV3Team `json:"awayTeam"`
+	GameID           string                   `json:"gameId"`
+	GameCode         string                   `json:"gameCode"`
+	GameStatus       int                      `json:"gameStatus"`     // 1=scheduled, 2=live, 3=final
+	GameStatusText   string                   `json:"gameStatusText"` // "Final", "Q3 2:34"
+	Period           int                      `json:"period"`         // current quarter
+	GameClock        string                   `json:"gameClock"`      // "PT02M34.00S" or ""
+	GameTimeUTC      string                   `json:"gameTimeUTC"`    // ISO8601
+	SeriesGameNumber string                   `json:"seriesGameNumber,omitempty"`
+	SeriesText       string                   `json:"seriesText,omitempty"` // "Celtics lead 2-1"
+	HomeTeam         scoreboardV3Team         `json:"homeTeam"`
+	AwayTeam         scoreboardV3Team         `json:"awayTeam"`
+	Broadcasters     scoreboardV3Broadcasters `json:"broadcasters"`
+}
+
+// scoreboardV3Broadcasters lists TV/radio coverage for a game.
+// Only the TV lists are used; national coverage takes precedence over local.
+type scoreboardV3Broadcasters struct {
+	NationalBroadcasters []scoreboardV3Broadcaster `json:"nationalBroadcasters"`
+	HomeTvBroadcasters   []scoreboardV3Broadcaster `json:"homeTvBroadcasters"`
+	AwayTvBroadcasters   []scoreboardV3Broadcaster `json:"awayTvBroadcasters"`
+}
+
+type scoreboardV3Broadcaster struct {
+	BroadcasterID      int    `json:"broadcasterId"`
+	BroadcasterDisplay string `json:"broadcasterDisplay"` // "ESPN", "NBCS-BOS"
 }
 
 type scoreboardV3Team struct {
@@ -154,6 +170,15 @@ type scoreboardV3Team struct {
 	Periods           []scoreboardV3Period `json:"periods,omitempty"`
 }
 
+// team converts the scoreboard team block to an api.Team.
+func (t scoreboardV3Team) team() api.Team {
+	return api.Team{
+		ID:        t.TeamID,
+		Name:      t.TeamCity + " " + t.TeamName,
+		ShortName: t.TeamTricode,
+	}
+}
+
 type scoreboardV3Period struct {
 	Period     int    `json:"period"`
 	PeriodType string `json:"periodType,omitempty"`
@@ -182,6 +207,68 @@ type standingsResponse struct {
 	ResultSets []resultSet `json:"resultSets"`
 }
 
+// scheduleLeagueV2Response is returned by GET /stats/scheduleleaguev2
+// Like scoreboardv3 it is nested JSON: leagueSchedule > gameDates[] > games[].
+type scheduleLeagueV2Response struct {
+	LeagueSchedule struct {
+		SeasonYear string               `json:"seasonYear"`
+		GameDates  []scheduleV2GameDate `json:"gameDates"`
+	} `json:"leagueSchedule"`
+}
+
+type scheduleV2GameDate struct {
+	GameDate string           `json:"gameDate"` // "10/21/2025 00:00:00"
+	Games    []scheduleV2Game `json:"games"`
+}
+
+type scheduleV2Game struct {
+	GameID          string                   `json:"gameId"`
+	GameStatus      int                      `json:"gameStatus"`      // 1=scheduled, 2=live, 3=final
+	GameStatusText  string                   `json:"gameStatusText"`  // "Final", "7:30 pm ET"
+	GameDateTimeUTC string                   `json:"gameDateTimeUTC"` // ISO8601
+	ArenaName       string                   `json:"arenaName"`
+	SeriesText      string                   `json:"seriesText,omitempty"`
+	HomeTeam        scoreboardV3Team         `json:"homeTeam"`
+	AwayTeam        scoreboardV3Team         `json:"awayTeam"`
+	Broadcasters    scoreboardV3Broadcasters `json:"broadcasters"`
+}
+
+// commonTeamRosterResponse is returned by GET /stats/commonteamroster
+type commonTeamRosterResponse struct {
+	ResultSets []resultSet `json:"resultSets"`
+}
+
+// leagueDashTeamStatsResponse is returned by GET /stats/leaguedashteamstats
+type leagueDashTeamStatsResponse struct {
+	ResultSets []resultSet `json:"resultSets"`
+}
+
+// commonPlayerInfoResponse is returned by GET /stats/commonplayerinfo
+type commonPlayerInfoResponse struct {
+	ResultSets []resultSet `json:"resultSets"`
+}
+
+// playerCareerStatsResponse is returned by GET /stats/playercareerstats
+type playerCareerStatsResponse struct {
+	ResultSets []resultSet `json:"resultSets"`
+}
+
+// playerGameLogResponse is returned by GET /stats/playergamelog
+type playerGameLogResponse struct {
+	ResultSets []resultSet `json:"resultSets"`
+}
+
+// leagueDashPlayerStatsResponse is returned by GET /stats/leaguedashplayerstats
+type leagueDashPlayerStatsResponse struct {
+	ResultSets []resultSet `json:"resultSets"`
+}
+
+// leagueLeadersResponse is returned by GET /stats/leagueleaders
+// Unlike the other endpoints it has a single "resultSet" object.
+type leagueLeadersResponse struct {
+	ResultSet resultSet `json:"resultSet"`
+}
+
 // scoreboardResponse kept for backward-compat while we still parse scoreboardv2 in test script
 // Remove once scoreboardv3 migration is complete.
 type scoreboardResponse = scoreboardV3Response
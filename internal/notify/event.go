@@ -0,0 +1,279 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+// EventType identifies the kind of game event a notification is about.
+type EventType string
+
+const (
+	EventScore       EventType = "score"         // baskets and free throws since the last poll
+	EventCorrection  EventType = "correction"    // a score went down, e.g. a basket overturned on review
+	EventTipOff      EventType = "tip_off"       // game started
+	EventEndOfPeriod EventType = "end_of_period" // end of the 1st or 3rd quarter
+	EventHalftime    EventType = "halftime"
+	EventFinal       EventType = "final"
+	EventOvertime    EventType = "overtime"    // an overtime period started
+	EventLeadChange  EventType = "lead_change" // the other team took the lead
+	EventCloseGame   EventType = "close_game"  // within 5 points in the last 5 minutes of Q4 or OT
+	EventBigRun      EventType = "big_run"     // one team scored BigRunPoints unanswered
+	EventEjection    EventType = "ejection"
+	EventMilestone   EventType = "milestone" // player milestone such as 40 points or a triple-double
+	EventRule        EventType = "rule"      // a condition rule became true; Detail is the rule name
+	EventSummary     EventType = "summary"   // notifications held back during quiet hours or a snooze
+	EventReminder    EventType = "reminder"  // a game with a reminder tips off soon
+)
+
+// EventTypes lists every event type in display order.
+var EventTypes = []EventType{
+	EventScore, EventCorrection, EventTipOff, EventEndOfPeriod, EventHalftime, EventFinal, EventOvertime,
+	EventLeadChange, EventCloseGame, EventBigRun, EventEjection, EventMilestone, EventRule, EventSummary, EventReminder,
+}
+
+// Event is a notification-worthy moment in a game.
+// Team and Player are set for events about one side or player; Detail carries
+// the type-specific part of the message ("12-0", "triple-double").
+type Event struct {
+	Type      EventType        `json:"type"`
+	MatchID   int              `json:"match_id"`
+	MatchTime *time.Time       `json:"match_time,omitempty"` // scheduled tip-off
+	HomeTeam  api.Team         `json:"home_team"`
+	AwayTeam  api.Team         `json:"away_team"`
+	HomeScore int              `json:"home_score"`
+	AwayScore int              `json:"away_score"`
+	Period    int              `json:"period,omitempty"` // 1-4, 5+ = overtime
+	Clock     string           `json:"clock,omitempty"`  // "2:34"
+	Team      *api.Team        `json:"team,omitempty"`
+	Player    string           `json:"player,omitempty"`
+	Detail    string           `json:"detail,omitempty"`
+	Play      *api.MatchEvent  `json:"play,omitempty"`  // latest scoring play for EventScore
+	Plays     []api.MatchEvent `json:"plays,omitempty"` // every scoring play since the last poll, oldest first
+	Time      time.Time        `json:"time"`
+}
+
+// Title returns the notification title for the event.
+func (e Event) Title() string {
+	switch e.Type {
+	case EventScore:
+		return constants.NotificationTitleGoal
+	case EventCorrection:
+		return constants.NotificationTitleCorrection
+	case EventTipOff:
+		return constants.NotificationTitleTipOff
+	case EventEndOfPeriod, EventHalftime:
+		return constants.NotificationTitlePeriod
+	case EventFinal:
+		return constants.NotificationTitleFinal
+	case EventOvertime:
+		return constants.NotificationTitleOvertime
+	case EventLeadChange, EventCloseGame, EventBigRun:
+		return constants.NotificationTitleMomentum
+	case EventEjection:
+		return constants.NotificationTitleEjection
+	case EventMilestone:
+		return constants.NotificationTitleMilestone
+	case EventRule:
+		return constants.NotificationTitleRule
+	case EventSummary:
+		return constants.NotificationTitleSummary
+	case EventReminder:
+		return constants.NotificationTitleReminder
+	}
+	return constants.NotificationTitleScore
+}
+
+// Message returns the notification body: a headline for the event and the
+// scoreline on a second line.
+func (e Event) Message() string {
+	if e.Type == EventScore && e.Play != nil && len(e.Plays) <= 1 {
+		return formatGoalMessage(*e.Play, e.HomeTeam, e.AwayTeam, e.HomeScore, e.AwayScore)
+	}
+	switch e.Type {
+	case EventSummary:
+		return e.Detail
+	case EventReminder:
+		return e.Headline()
+	}
+	return e.Headline() + "\n" + e.Scoreline()
+}
+
+// Headline returns a one-line description of the event without the score.
+func (e Event) Headline() string {
+	team := ""
+	if e.Team != nil {
+		team = teamLabel(*e.Team)
+	}
+	at := e.periodClock()
+
+	switch e.Type {
+	case EventScore:
+		return e.scoringSummary()
+	case EventCorrection:
+		return fmt.Sprintf("Score corrected: %s  %s", e.Detail, at)
+	case EventTipOff:
+		return fmt.Sprintf("%s @ %s is underway", teamLabel(e.AwayTeam), teamLabel(e.HomeTeam))
+	case EventEndOfPeriod:
+		return "End of " + PeriodLabel(e.Period)
+	case EventHalftime:
+		return "Halftime"
+	case EventFinal:
+		final := "Final"
+		if e.Period > 4 {
+			final = "Final/" + PeriodLabel(e.Period)
+		}
+		winner := e.HomeTeam
+		if e.AwayScore > e.HomeScore {
+			winner = e.AwayTeam
+		}
+		return fmt.Sprintf("%s · %s win", final, teamLabel(winner))
+	case EventOvertime:
+		return fmt.Sprintf("Tied at %d · %s", e.HomeScore, PeriodLabel(e.Period))
+	case EventLeadChange:
+		return fmt.Sprintf("%s take the lead  %s", team, at)
+	case EventCloseGame:
+		margin := e.HomeScore - e.AwayScore
+		if margin < 0 {
+			margin = -margin
+		}
+		if margin == 0 {
+			return fmt.Sprintf("Tie game  %s", at)
+		}
+		return fmt.Sprintf("%d-point game  %s", margin, at)
+	case EventBigRun:
+		return fmt.Sprintf("%s on a %s run  %s", team, e.Detail, at)
+	case EventEjection:
+		return fmt.Sprintf("%s ejected (%s)  %s", e.Player, team, at)
+	case EventMilestone:
+		return fmt.Sprintf("%s: %s (%s)", e.Player, e.Detail, team)
+	case EventRule:
+		return fmt.Sprintf("%s  %s", e.Detail, at)
+	case EventSummary:
+		headline, _, _ := strings.Cut(e.Detail, "\n")
+		return headline
+	case EventReminder:
+		at := ""
+		if e.MatchTime != nil {
+			at = " (" + e.MatchTime.Local().Format("15:04") + ")"
+		}
+		return fmt.Sprintf("%s @ %s tips off %s%s", teamLabel(e.AwayTeam), teamLabel(e.HomeTeam), e.Detail, at)
+	}
+	return e.Detail
+}
+
+// maxSummaryPlays is how many plays a scoring summary lists before "+N more".
+const maxSummaryPlays = 4
+
+// scoringSummary renders the scoring plays of an EventScore:
+// "BOS 7-0 run: J. Tatum 3PT, J. Brown 2PT, J. Tatum FT, J. Tatum FT".
+func (e Event) scoringSummary() string {
+	if len(e.Plays) == 0 {
+		if e.Play != nil {
+			return playSummary(*e.Play)
+		}
+		return e.Detail
+	}
+	if len(e.Plays) == 1 {
+		return fmt.Sprintf("%s (%s)  %s", playSummary(e.Plays[0]), teamLabel(e.Plays[0].Team), e.periodClock())
+	}
+
+	home, away := 0, 0
+	for _, p := range e.Plays {
+		if p.Team.ID == e.AwayTeam.ID {
+			away += playPoints(p)
+		} else {
+			home += playPoints(p)
+		}
+	}
+	var lead string
+	switch {
+	case away == 0:
+		lead = fmt.Sprintf("%s %d-0 run", teamLabel(e.HomeTeam), home)
+	case home == 0:
+		lead = fmt.Sprintf("%s %d-0 run", teamLabel(e.AwayTeam), away)
+	default:
+		lead = fmt.Sprintf("%s +%d, %s +%d", teamLabel(e.HomeTeam), home, teamLabel(e.AwayTeam), away)
+	}
+
+	var plays []string
+	for i, p := range e.Plays {
+		if i == maxSummaryPlays {
+			plays = append(plays, fmt.Sprintf("+%d more", len(e.Plays)-i))
+			break
+		}
+		plays = append(plays, playSummary(p))
+	}
+	return lead + ": " + strings.Join(plays, ", ")
+}
+
+// playSummary renders a scoring play as "J. Tatum 3PT".
+func playSummary(p api.MatchEvent) string {
+	player := teamLabel(p.Team)
+	if p.Player != nil && *p.Player != "" {
+		player = *p.Player
+	}
+	return player + " " + playLabel(p)
+}
+
+// playLabel returns "3PT", "2PT", "FT" or "GOAL".
+func playLabel(p api.MatchEvent) string {
+	switch p.Type {
+	case "field_goal":
+		if p.IsThree != nil && *p.IsThree {
+			return "3PT"
+		}
+		return "2PT"
+	case "free_throw":
+		return "FT"
+	}
+	return "GOAL"
+}
+
+// playPoints returns the points of a scoring play; 1 when unknown.
+func playPoints(p api.MatchEvent) int {
+	if p.Points == nil {
+		return 1
+	}
+	return *p.Points
+}
+
+// Scoreline renders "BOS 89 - 79 MIA" (home first, like the score notification).
+func (e Event) Scoreline() string {
+	return fmt.Sprintf("%s %d - %d %s", e.HomeTeam.ShortName, e.HomeScore, e.AwayScore, e.AwayTeam.ShortName)
+}
+
+// periodClock renders "Q4 2:34", or just the period when the clock is unknown.
+func (e Event) periodClock() string {
+	if e.Period == 0 {
+		return e.Clock
+	}
+	if e.Clock == "" {
+		return PeriodLabel(e.Period)
+	}
+	return PeriodLabel(e.Period) + " " + e.Clock
+}
+
+// PeriodLabel renders a period number as "Q1".."Q4", "OT", "OT2", ...
+func PeriodLabel(period int) string {
+	switch {
+	case period <= 4:
+		return fmt.Sprintf("Q%d", period)
+	case period == 5:
+		return "OT"
+	default:
+		return fmt.Sprintf("OT%d", period-4)
+	}
+}
+
+// teamLabel prefers the tricode.
+func teamLabel(t api.Team) string {
+	if t.ShortName != "" {
+		return t.ShortName
+	}
+	return t.Name
+}
@@ -0,0 +1,194 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// historyFileName is the notification history in the state directory.
+const historyFileName = "notifications.json"
+
+// MaxHistory is how many notifications the history keeps; older ones are dropped.
+const MaxHistory = 200
+
+// HistoryEntry is one fired notification.
+type HistoryEntry struct {
+	ID        int64      `json:"id"`
+	Time      time.Time  `json:"time"`
+	Type      EventType  `json:"type"`
+	MatchID   int        `json:"match_id"`
+	MatchTime *time.Time `json:"match_time,omitempty"`
+	HomeTeam  api.Team   `json:"home_team"`
+	AwayTeam  api.Team   `json:"away_team"`
+	Title     string     `json:"title"`
+	Message   string     `json:"message"`
+	Read      bool       `json:"read,omitempty"`
+}
+
+// Match returns the game the notification is about, enough to open it.
+func (h HistoryEntry) Match() api.Match {
+	return api.Match{ID: h.MatchID, HomeTeam: h.HomeTeam, AwayTeam: h.AwayTeam, MatchTime: h.MatchTime}
+}
+
+// History is the on-disk record of fired notifications. Every call reads and
+// rewrites the file, so the app and the daemon can share one history.
+type History struct {
+	mu   sync.Mutex
+	path string
+}
+
+// HistoryPath returns the path of the notification history file.
+func HistoryPath() (string, error) {
+	dir, err := data.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, historyFileName), nil
+}
+
+// NewHistory creates a history stored at path.
+func NewHistory(path string) *History {
+	return &History{path: path}
+}
+
+// Add records e as fired now, dropping the oldest entries beyond MaxHistory.
+func (h *History) Add(e Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries, err := h.load()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	id := now.UnixNano()
+	if n := len(entries); n > 0 && entries[n-1].ID >= id {
+		id = entries[n-1].ID + 1
+	}
+	entries = append(entries, HistoryEntry{
+		ID:        id,
+		Time:      now,
+		Type:      e.Type,
+		MatchID:   e.MatchID,
+		MatchTime: e.MatchTime,
+		HomeTeam:  e.HomeTeam,
+		AwayTeam:  e.AwayTeam,
+		Title:     e.Title(),
+		Message:   e.Message(),
+	})
+	if len(entries) > MaxHistory {
+		entries = entries[len(entries)-MaxHistory:]
+	}
+	return h.save(entries)
+}
+
+// Entries returns the history, newest first.
+func (h *History) Entries() ([]HistoryEntry, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries, err := h.load()
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(entries)
+	return entries, nil
+}
+
+// Unread returns the number of unread entries.
+func (h *History) Unread() (int, error) {
+	entries, err := h.Entries()
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, e := range entries {
+		if !e.Read {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// MarkRead marks the entries with the given IDs read; with no IDs, every entry.
+func (h *History) MarkRead(ids ...int64) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries, err := h.load()
+	if err != nil {
+		return err
+	}
+	changed := false
+	for i := range entries {
+		if !entries[i].Read && (len(ids) == 0 || slices.Contains(ids, entries[i].ID)) {
+			entries[i].Read = true
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return h.save(entries)
+}
+
+// load reads the history, oldest first. A missing file is an empty history.
+func (h *History) load() ([]HistoryEntry, error) {
+	b, err := os.ReadFile(h.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read notification history: %w", err)
+	}
+	var entries []HistoryEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		// A corrupt history is started over rather than blocking notifications
+		return nil, nil
+	}
+	return entries, nil
+}
+
+// save writes the history atomically so a concurrent reader never sees half a file.
+func (h *History) save(entries []HistoryEntry) error {
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("encode notification history: %w", err)
+	}
+	if err := writeFileAtomic(h.path, b); err != nil {
+		return fmt.Errorf("write notification history: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes b to a temporary file next to path and renames it
+// over path.
+func writeFileAtomic(path string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
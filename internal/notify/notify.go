@@ -1,5 +1,6 @@
-// Package notify provides desktop notification functionality for match events.
-// Currently supports macOS, Linux, and Windows via the beeep library.
+// Package notify provides desktop notification functionality for game events.
+// Events are detected from live game snapshots by a Tracker and delivered by a
+// Notifier. Desktop notifications support macOS, Linux, and Windows via the beeep library.
 package notify
 
 import (
@@ -7,10 +8,10 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gabriel7419/courtside/internal/api"
 	"github.com/gabriel7419/courtside/internal/assets"
-	"github.com/gabriel7419/courtside/internal/constants"
 	"github.com/gabriel7419/courtside/internal/data"
 	"github.com/gen2brain/beeep"
 )
@@ -45,48 +46,45 @@ func getIconPath() string {
 // Notifier defines the interface for sending desktop notifications.
 // This allows for easy mocking in tests and potential future implementations.
 type Notifier interface {
-	// Goal sends a notification for a new goal event.
-	Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+	// Notify sends a notification for a game event.
+	Notify(event Event) error
 }
 
 // DesktopNotifier implements Notifier using native desktop notifications.
+// It is safe for concurrent use: notifications are sent from commands while
+// the UI toggles them.
 type DesktopNotifier struct {
-	enabled bool
+	enabled atomic.Bool
 }
 
 // NewDesktopNotifier creates a new desktop notifier.
 // Notifications are enabled by default.
 func NewDesktopNotifier() *DesktopNotifier {
-	return &DesktopNotifier{
-		enabled: true,
-	}
+	n := &DesktopNotifier{}
+	n.enabled.Store(true)
+	return n
 }
 
 // SetEnabled enables or disables notifications.
 func (n *DesktopNotifier) SetEnabled(enabled bool) {
-	n.enabled = enabled
+	n.enabled.Store(enabled)
 }
 
 // Enabled returns whether notifications are currently enabled.
 func (n *DesktopNotifier) Enabled() bool {
-	return n.enabled
+	return n.enabled.Load()
 }
 
-// Goal sends a desktop notification for a new scoring event.
-// Works for both football goals and NBA field goals / free throws.
-func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
-	if !n.enabled {
+// Notify sends a desktop notification for a game event.
+func (n *DesktopNotifier) Notify(event Event) error {
+	if !n.enabled.Load() {
 		return nil
 	}
 
 	// Play terminal beep via stderr
 	_, _ = os.Stderr.WriteString("\a")
 
-	// Build notification content
-	title := constants.NotificationTitleGoal
-	message := formatGoalMessage(event, homeTeam, awayTeam, homeScore, awayScore)
-
-	_ = beeep.Notify(title, message, getIconPath())
+	_ = beeep.Notify(event.Title(), event.Message(), getIconPath())
 	return nil
 }
 
@@ -0,0 +1,209 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// SnoozeDuration is how long the snooze key silences notifications.
+const SnoozeDuration = time.Hour
+
+// snoozeFileName holds the end of the current snooze in the state directory,
+// so a snooze from the app also silences the daemon.
+const snoozeFileName = "notify-snooze"
+
+// heldFileName keeps, in the state directory, the notifications held back
+// during quiet hours or a snooze, one JSON event per line. Being on disk, the
+// summary survives a restart, and the app and the daemon share it.
+const heldFileName = "notifications-held.jsonl"
+
+// Snooze silences desktop notifications and bells until until; the zero time
+// ends the snooze.
+func Snooze(until time.Time) error {
+	dir, err := data.StateDir()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, snoozeFileName)
+	if until.IsZero() {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("end snooze: %w", err)
+		}
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(until.Format(time.RFC3339)), 0644); err != nil {
+		return fmt.Errorf("snooze: %w", err)
+	}
+	return nil
+}
+
+// SnoozedUntil returns when the current snooze ends, or the zero time when
+// notifications are not snoozed.
+func SnoozedUntil() time.Time {
+	dir, err := data.StateDir()
+	if err != nil {
+		return time.Time{}
+	}
+	b, err := os.ReadFile(filepath.Join(dir, snoozeFileName))
+	if err != nil {
+		return time.Time{}
+	}
+	until, err := time.Parse(time.RFC3339, strings.TrimSpace(string(b)))
+	if err != nil || !until.After(time.Now()) {
+		return time.Time{}
+	}
+	return until
+}
+
+// inQuietHours reports whether t falls within the quiet hours. The range may
+// cross midnight ("23:00" to "07:30"); an invalid range is never quiet.
+func inQuietHours(q *data.QuietHours, t time.Time) bool {
+	if q == nil {
+		return false
+	}
+	start, ok1 := minuteOfDay(q.Start)
+	end, ok2 := minuteOfDay(q.End)
+	if !ok1 || !ok2 || start == end {
+		return false
+	}
+	now := t.Hour()*60 + t.Minute()
+	if start < end {
+		return now >= start && now < end
+	}
+	return now >= start || now < end
+}
+
+// minuteOfDay parses "23:30" into minutes after midnight.
+func minuteOfDay(s string) (int, bool) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// heldPath returns the path of the held notifications file.
+func heldPath() (string, error) {
+	dir, err := data.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, heldFileName), nil
+}
+
+// holdEvents adds events to the ones held back for the summary. Each is one
+// appended line, so concurrent writers don't lose each other's events.
+func holdEvents(events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	path, err := heldPath()
+	if err != nil {
+		return err
+	}
+	var b []byte
+	for _, e := range events {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("encode held notification: %w", err)
+		}
+		b = append(append(b, line...), '\n')
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("hold notifications: %w", err)
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("hold notifications: %w", err)
+	}
+	return nil
+}
+
+// takeHeld returns the held notifications and removes them. The file is
+// renamed away first, so of the app and the daemon only one takes them.
+func takeHeld() ([]Event, error) {
+	path, err := heldPath()
+	if err != nil {
+		return nil, err
+	}
+	taken := fmt.Sprintf("%s.%d", path, os.Getpid())
+	if err := os.Rename(path, taken); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("take held notifications: %w", err)
+	}
+	defer os.Remove(taken)
+
+	f, err := os.Open(taken)
+	if err != nil {
+		return nil, fmt.Errorf("take held notifications: %w", err)
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var e Event
+		// A line cut short by a crash is skipped rather than losing the rest
+		if err := json.Unmarshal(scanner.Bytes(), &e); err == nil {
+			events = append(events, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return events, fmt.Errorf("read held notifications: %w", err)
+	}
+	return events, nil
+}
+
+// newSummary creates the EventSummary for notifications held back while paused:
+// a count per event type and the latest score of each game.
+func newSummary(held []Event) Event {
+	var order []EventType
+	counts := make(map[EventType]int)
+	var games []int
+	latest := make(map[int]Event)
+	for _, e := range held {
+		if counts[e.Type] == 0 {
+			order = append(order, e.Type)
+		}
+		counts[e.Type]++
+		if _, ok := latest[e.MatchID]; !ok {
+			games = append(games, e.MatchID)
+		}
+		latest[e.MatchID] = e
+	}
+
+	var parts []string
+	for _, t := range order {
+		label := strings.ReplaceAll(string(t), "_", " ")
+		if counts[t] > 1 {
+			label += fmt.Sprintf(" ×%d", counts[t])
+		}
+		parts = append(parts, label)
+	}
+	var scores []string
+	for _, id := range games {
+		scores = append(scores, latest[id].Scoreline())
+	}
+
+	// The scores go on Detail's second line, in place of a scoreline
+	return Event{
+		Type:   EventSummary,
+		Detail: fmt.Sprintf("%d held back: %s\n%s", len(held), strings.Join(parts, ", "), strings.Join(scores, " · ")),
+		Time:   time.Now(),
+	}
+}
@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+func TestInQuietHours(t *testing.T) {
+	night := &data.QuietHours{Start: "23:00", End: "07:30"}
+	lunch := &data.QuietHours{Start: "12:00", End: "13:00"}
+	tests := []struct {
+		name  string
+		quiet *data.QuietHours
+		clock string
+		want  bool
+	}{
+		{"unset", nil, "23:30", false},
+		{"before the night", night, "22:59", false},
+		{"night starts", night, "23:00", true},
+		{"before midnight", night, "23:30", true},
+		{"after midnight", night, "03:00", true},
+		{"night ends", night, "07:30", false},
+		{"midday", night, "12:00", false},
+		{"within the day", lunch, "12:30", true},
+		{"after the day", lunch, "13:00", false},
+		{"invalid", &data.QuietHours{Start: "late", End: "07:00"}, "23:30", false},
+		{"empty range", &data.QuietHours{Start: "07:00", End: "07:00"}, "07:00", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := time.ParseInLocation("15:04", tt.clock, time.Local)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := inQuietHours(tt.quiet, at); got != tt.want {
+				t.Errorf("inQuietHours(%s) = %v, want %v", tt.clock, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeldNotificationsSurviveRestart(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	if err := Snooze(time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	snoozed := &recordingNotifier{}
+	if err := NewDispatcher(snoozed, nil).Send(testEvent()); err != nil {
+		t.Fatalf("Send() = %v", err)
+	}
+	if len(snoozed.events) != 0 {
+		t.Fatalf("snoozed dispatcher notified %v", snoozed.events)
+	}
+
+	// A new dispatcher, as after a restart, sends the summary once the snooze ends
+	if err := Snooze(time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	restarted := &recordingNotifier{}
+	d := NewDispatcher(restarted, nil)
+	if err := d.Flush(); err != nil {
+		t.Fatalf("Flush() = %v", err)
+	}
+	if len(restarted.events) != 1 || restarted.events[0].Type != EventSummary ||
+		!strings.HasPrefix(restarted.events[0].Detail, "1 held back: final") {
+		t.Fatalf("Flush() notified %+v, want the summary of the final", restarted.events)
+	}
+
+	if err := d.Flush(); err != nil || len(restarted.events) != 1 {
+		t.Errorf("second Flush() = %v after %d notifications, want no second summary", err, len(restarted.events))
+	}
+}
@@ -0,0 +1,279 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// DefaultReminderMinutes is how long before tip-off a reminder fires unless
+// settings or the reminder itself say otherwise.
+const DefaultReminderMinutes = 15
+
+// ReminderLeadTimes are the lead times, in minutes, a reminder can be cycled through.
+var ReminderLeadTimes = []int{5, 15, 30, 60}
+
+// reminderGrace is how late a reminder may still fire, for when neither the
+// app nor the daemon was running at its time.
+const reminderGrace = 10 * time.Minute
+
+const (
+	remindersFileName = "reminders.json"
+	firedDirName      = "reminders-fired" // markers of fired reminders, one file each
+)
+
+// Reminder asks for a notification shortly before a game tips off.
+type Reminder struct {
+	MatchID   int       `json:"match_id"`
+	MatchTime time.Time `json:"match_time"`
+	HomeTeam  api.Team  `json:"home_team"`
+	AwayTeam  api.Team  `json:"away_team"`
+	Minutes   int       `json:"minutes,omitempty"` // lead time; 0 uses the default
+}
+
+// LeadMinutes returns the reminder's lead time in minutes.
+func (r Reminder) LeadMinutes(defaultMinutes int) int {
+	if r.Minutes > 0 {
+		return r.Minutes
+	}
+	if defaultMinutes > 0 {
+		return defaultMinutes
+	}
+	return DefaultReminderMinutes
+}
+
+// Reminders is the on-disk list of tip-off reminders, shared by the app and
+// the daemon. Every call reads and rewrites the file.
+type Reminders struct {
+	mu       sync.Mutex
+	path     string
+	firedDir string
+}
+
+// RemindersPath returns the path of the reminders file.
+func RemindersPath() (string, error) {
+	dir, err := data.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, remindersFileName), nil
+}
+
+// NewReminders creates a reminder list stored at path. Fired markers are kept
+// in a directory next to it.
+func NewReminders(path string) *Reminders {
+	return &Reminders{path: path, firedDir: filepath.Join(filepath.Dir(path), firedDirName)}
+}
+
+// Get returns the reminder for a game, if there is one.
+func (r *Reminders) Get(matchID int) (Reminder, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list, err := r.load()
+	if err != nil {
+		return Reminder{}, false
+	}
+	for _, rem := range list {
+		if rem.MatchID == matchID {
+			return rem, true
+		}
+	}
+	return Reminder{}, false
+}
+
+// Set adds or replaces the reminder for rem.MatchID.
+func (r *Reminders) Set(rem Reminder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list, err := r.load()
+	if err != nil {
+		return err
+	}
+	list = slices.DeleteFunc(list, func(x Reminder) bool { return x.MatchID == rem.MatchID })
+	list = append(list, rem)
+	return r.save(list)
+}
+
+// Remove deletes the reminder for a game.
+func (r *Reminders) Remove(matchID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list, err := r.load()
+	if err != nil {
+		return err
+	}
+	return r.save(slices.DeleteFunc(list, func(x Reminder) bool { return x.MatchID == matchID }))
+}
+
+// Toggle sets a reminder for match with the default lead time, or removes the
+// existing one. It reports whether the game now has a reminder.
+func (r *Reminders) Toggle(match api.Match) (bool, error) {
+	if _, ok := r.Get(match.ID); ok {
+		return false, r.Remove(match.ID)
+	}
+	if match.MatchTime == nil {
+		return false, errors.New("game has no tip-off time")
+	}
+	return true, r.Set(Reminder{
+		MatchID:   match.ID,
+		MatchTime: *match.MatchTime,
+		HomeTeam:  match.HomeTeam,
+		AwayTeam:  match.AwayTeam,
+	})
+}
+
+// List returns every pending reminder.
+func (r *Reminders) List() ([]Reminder, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load()
+}
+
+// Fire sends every reminder whose time has come and removes it, along with
+// reminders too late to fire. Each reminder is claimed with a marker file
+// created exclusively, so when the app and the daemon both run, only one of
+// them sends it. A reminder that fails to send is unclaimed and kept, to be
+// tried again on the next call until it is too late.
+func (r *Reminders) Fire(now time.Time, defaultMinutes int, send func(Reminder) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	done := make(map[int]bool)
+	for _, rem := range list {
+		switch {
+		case now.After(rem.MatchTime.Add(reminderGrace)):
+			done[rem.MatchID] = true
+		case !now.Before(rem.MatchTime.Add(-time.Duration(rem.LeadMinutes(defaultMinutes)) * time.Minute)):
+			claimed, err := r.claim(rem)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if claimed {
+				if err := send(rem); err != nil {
+					errs = append(errs, err, r.unclaim(rem))
+					continue
+				}
+			}
+			done[rem.MatchID] = true
+		}
+	}
+	if len(done) == 0 {
+		return errors.Join(errs...)
+	}
+
+	// Reload: the other process may have changed the list while sending
+	if list, err = r.load(); err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	list = slices.DeleteFunc(list, func(x Reminder) bool { return done[x.MatchID] })
+	if err := r.save(list); err != nil {
+		errs = append(errs, err)
+	}
+	r.cleanMarkers(now)
+	return errors.Join(errs...)
+}
+
+// claim creates the fired marker of rem and reports whether this call created it.
+func (r *Reminders) claim(rem Reminder) (bool, error) {
+	if err := os.MkdirAll(r.firedDir, 0755); err != nil {
+		return false, fmt.Errorf("claim reminder: %w", err)
+	}
+	f, err := os.OpenFile(r.markerPath(rem), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if errors.Is(err, os.ErrExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claim reminder: %w", err)
+	}
+	return true, f.Close()
+}
+
+// unclaim removes the fired marker of rem, so that it can be sent again.
+func (r *Reminders) unclaim(rem Reminder) error {
+	if err := os.Remove(r.markerPath(rem)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unclaim reminder: %w", err)
+	}
+	return nil
+}
+
+// markerPath returns the path of the fired marker of rem.
+func (r *Reminders) markerPath(rem Reminder) string {
+	return filepath.Join(r.firedDir, fmt.Sprintf("%d-%d", rem.MatchID, rem.MatchTime.Unix()))
+}
+
+// cleanMarkers removes fired markers older than two days.
+func (r *Reminders) cleanMarkers(now time.Time) {
+	entries, err := os.ReadDir(r.firedDir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if info, err := e.Info(); err == nil && now.Sub(info.ModTime()) > 48*time.Hour {
+			_ = os.Remove(filepath.Join(r.firedDir, e.Name()))
+		}
+	}
+}
+
+// load reads the reminders. A missing or corrupt file is an empty list.
+func (r *Reminders) load() ([]Reminder, error) {
+	b, err := os.ReadFile(r.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read reminders: %w", err)
+	}
+	var list []Reminder
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, nil
+	}
+	return list, nil
+}
+
+// save writes the reminders atomically.
+func (r *Reminders) save(list []Reminder) error {
+	b, err := json.Marshal(list)
+	if err != nil {
+		return fmt.Errorf("encode reminders: %w", err)
+	}
+	if err := writeFileAtomic(r.path, b); err != nil {
+		return fmt.Errorf("write reminders: %w", err)
+	}
+	return nil
+}
+
+// NewReminderEvent creates the notification for a reminder firing at now.
+func NewReminderEvent(rem Reminder, now time.Time) Event {
+	matchTime := rem.MatchTime
+	detail := "now"
+	if left := matchTime.Sub(now).Round(time.Minute); left > 0 {
+		detail = fmt.Sprintf("in %d min", int(left.Minutes()))
+	}
+	return Event{
+		Type:      EventReminder,
+		MatchID:   rem.MatchID,
+		MatchTime: &matchTime,
+		HomeTeam:  rem.HomeTeam,
+		AwayTeam:  rem.AwayTeam,
+		Detail:    detail,
+		Time:      now,
+	}
+}
@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRemindersFire(t *testing.T) {
+	tipOff := time.Now().Add(time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name     string
+		minutes  int
+		now      time.Time
+		wantSent bool
+		wantKept bool
+	}{
+		{"before the lead time", 0, tipOff.Add(-20 * time.Minute), false, true},
+		{"at the lead time", 0, tipOff.Add(-15 * time.Minute), true, false},
+		{"late but within the grace", 0, tipOff.Add(5 * time.Minute), true, false},
+		{"too late", 0, tipOff.Add(11 * time.Minute), false, false},
+		{"own lead time", 60, tipOff.Add(-40 * time.Minute), true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReminders(filepath.Join(t.TempDir(), remindersFileName))
+			if err := r.Set(Reminder{MatchID: 42, MatchTime: tipOff, Minutes: tt.minutes}); err != nil {
+				t.Fatal(err)
+			}
+
+			var sent []Reminder
+			err := r.Fire(tt.now, DefaultReminderMinutes, func(rem Reminder) error {
+				sent = append(sent, rem)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Fire() = %v", err)
+			}
+			if got := len(sent) == 1; got != tt.wantSent {
+				t.Errorf("Fire() sent %v, want sent %v", sent, tt.wantSent)
+			}
+			if _, kept := r.Get(42); kept != tt.wantKept {
+				t.Errorf("reminder kept = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestRemindersFireOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), remindersFileName)
+	app, daemon := NewReminders(path), NewReminders(path)
+	tipOff := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	rem := Reminder{MatchID: 42, MatchTime: tipOff}
+
+	sent := 0
+	send := func(Reminder) error {
+		sent++
+		return nil
+	}
+	if err := app.Set(rem); err != nil {
+		t.Fatal(err)
+	}
+	if err := app.Fire(time.Now(), 0, send); err != nil {
+		t.Fatalf("Fire() = %v", err)
+	}
+	// The daemon read the list before the app removed the reminder
+	if err := daemon.Set(rem); err != nil {
+		t.Fatal(err)
+	}
+	if err := daemon.Fire(time.Now(), 0, send); err != nil {
+		t.Fatalf("second Fire() = %v", err)
+	}
+	if sent != 1 {
+		t.Errorf("reminder sent %d times, want once", sent)
+	}
+	if list, err := daemon.List(); err != nil || len(list) != 0 {
+		t.Errorf("List() = %v, %v, want the fired reminder removed", list, err)
+	}
+}
+
+func TestRemindersFireRetriesFailedSend(t *testing.T) {
+	r := NewReminders(filepath.Join(t.TempDir(), remindersFileName))
+	tipOff := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	if err := r.Set(Reminder{MatchID: 42, MatchTime: tipOff}); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := errors.New("notifier down")
+	err := r.Fire(time.Now(), 0, func(Reminder) error { return failed })
+	if !errors.Is(err, failed) {
+		t.Fatalf("Fire() = %v, want the send error", err)
+	}
+	if _, kept := r.Get(42); !kept {
+		t.Fatal("reminder removed after a failed send, want it kept for a retry")
+	}
+
+	sent := 0
+	if err := r.Fire(time.Now(), 0, func(Reminder) error { sent++; return nil }); err != nil {
+		t.Fatalf("second Fire() = %v", err)
+	}
+	if sent != 1 {
+		t.Errorf("retry sent the reminder %d times, want once", sent)
+	}
+	if _, kept := r.Get(42); kept {
+		t.Error("reminder kept after it was sent")
+	}
+}
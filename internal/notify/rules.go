@@ -0,0 +1,458 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// logFileName is the notification log written by the "log" action.
+const logFileName = "notifications.log"
+
+// Dispatcher runs the notification rules on every live update of a game:
+// it detects events with a Tracker, picks each event's action from the rules
+// and delivers it.
+type Dispatcher struct {
+	tracker  *Tracker
+	notifier Notifier
+
+	mu            sync.Mutex
+	rules         []data.NotificationRule
+	defaultAction string
+	favorites     []string
+	history       *History            // records every notification that is not dropped
+	quietHours    *data.QuietHours    // nil when not configured
+	seen          map[int]bool        // games with a baseline
+	active        map[activeRule]bool // condition rules currently true
+	muted         map[int]bool        // games muted for this session
+}
+
+// activeRule is a condition rule that is true for a game. Rules are keyed by
+// their content, so editing the rules doesn't carry one rule's state over to
+// another.
+type activeRule struct {
+	matchID int
+	rule    string
+}
+
+// NewDispatcher creates a dispatcher delivering to notifier with the rules in settings.
+func NewDispatcher(notifier Notifier, settings *data.Settings) *Dispatcher {
+	d := &Dispatcher{
+		tracker:  NewTracker(),
+		notifier: notifier,
+		seen:     make(map[int]bool),
+		active:   make(map[activeRule]bool),
+		muted:    make(map[int]bool),
+	}
+	d.SetSettings(settings)
+	return d
+}
+
+// SetSettings replaces the rules and favorite teams. Condition rules that are
+// kept stay true, so they don't fire again; the state of removed ones is dropped.
+func (d *Dispatcher) SetSettings(settings *data.Settings) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rules, d.defaultAction, d.favorites, d.quietHours = nil, data.NotifyActionDesktop, nil, nil
+	if settings != nil {
+		d.rules = settings.Notifications.Rules
+		d.quietHours = settings.Notifications.QuietHours
+		if settings.Notifications.DefaultAction != "" {
+			d.defaultAction = settings.Notifications.DefaultAction
+		}
+		d.favorites = settings.FavoriteTeams
+	}
+
+	kept := make(map[string]bool, len(d.rules))
+	for _, rule := range d.rules {
+		kept[ruleKey(rule)] = true
+	}
+	for key := range d.active {
+		if !kept[key.rule] {
+			delete(d.active, key)
+		}
+	}
+}
+
+// SetHistory records every delivered notification in h.
+func (d *Dispatcher) SetHistory(h *History) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.history = h
+}
+
+// SetMuted mutes or unmutes a game: its desktop notifications and bells are
+// only logged. Mutes last until the dispatcher is discarded.
+func (d *Dispatcher) SetMuted(matchID int, muted bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if muted {
+		d.muted[matchID] = true
+	} else {
+		delete(d.muted, matchID)
+	}
+}
+
+// Muted reports whether a game is muted.
+func (d *Dispatcher) Muted(matchID int) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.muted[matchID]
+}
+
+// Paused reports whether quiet hours or a snooze are holding notifications back.
+func (d *Dispatcher) Paused() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.paused()
+}
+
+// paused is Paused with d.mu held.
+func (d *Dispatcher) paused() bool {
+	return inQuietHours(d.quietHours, time.Now()) || !SnoozedUntil().IsZero()
+}
+
+// Flush delivers the summary of notifications held back during quiet hours or
+// a snooze once neither applies anymore. Update flushes on its own; Flush is
+// for when no game is being updated.
+func (d *Dispatcher) Flush() error {
+	d.mu.Lock()
+	summary, ok, err := d.takeSummary()
+	d.mu.Unlock()
+	if !ok {
+		return err
+	}
+	return errors.Join(err, d.deliver(summary, data.NotifyActionDesktop))
+}
+
+// takeSummary returns the summary of the held notifications and clears them,
+// if there are any and the pause is over. d.mu must be held.
+func (d *Dispatcher) takeSummary() (Event, bool, error) {
+	if d.paused() {
+		return Event{}, false, nil
+	}
+	held, err := takeHeld()
+	if len(held) == 0 {
+		return Event{}, false, err
+	}
+	return newSummary(held), true, err
+}
+
+// Reset forgets every game, so the next update of each only sets a baseline.
+func (d *Dispatcher) Reset() {
+	d.tracker.Reset()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.seen = make(map[int]bool)
+	d.active = make(map[activeRule]bool)
+}
+
+// Update evaluates the rules against a new snapshot of a game and delivers
+// every resulting notification. Delivery errors are returned joined but do not
+// stop other notifications.
+func (d *Dispatcher) Update(details *api.MatchDetails) error {
+	if details == nil {
+		return nil
+	}
+	events := d.tracker.Observe(details)
+
+	d.mu.Lock()
+	var deliveries []delivery
+	summary, ok, summaryErr := d.takeSummary()
+	if ok {
+		deliveries = append(deliveries, delivery{summary, data.NotifyActionDesktop})
+	}
+	for _, e := range events {
+		deliveries = append(deliveries, delivery{e, d.actionFor(e, details)})
+	}
+
+	baseline := !d.seen[details.ID]
+	d.seen[details.ID] = true
+	for _, rule := range d.rules {
+		if len(rule.Events) > 0 {
+			continue
+		}
+		key := activeRule{matchID: details.ID, rule: ruleKey(rule)}
+		holds := details.Status == api.MatchStatusLive && d.matches(rule, details, nil)
+		if holds && !d.active[key] && !baseline {
+			e := newEvent(EventRule, details)
+			e.Detail = ruleName(rule)
+			deliveries = append(deliveries, delivery{e, rule.Action})
+		}
+		// Only true rules are kept, so finished games leave nothing behind
+		if holds {
+			d.active[key] = true
+		} else {
+			delete(d.active, key)
+		}
+	}
+
+	holdErr := d.holdBack(deliveries)
+	history := d.history
+	d.mu.Unlock()
+
+	return errors.Join(summaryErr, holdErr, d.deliverAll(deliveries, history))
+}
+
+// Send delivers an event that does not come from a game update, such as a
+// reminder, as a desktop notification. Quiet hours, snoozes and mutes apply.
+func (d *Dispatcher) Send(e Event) error {
+	d.mu.Lock()
+	var deliveries []delivery
+	summary, ok, summaryErr := d.takeSummary()
+	if ok {
+		deliveries = append(deliveries, delivery{summary, data.NotifyActionDesktop})
+	}
+	deliveries = append(deliveries, delivery{e, data.NotifyActionDesktop})
+	holdErr := d.holdBack(deliveries)
+	history := d.history
+	d.mu.Unlock()
+
+	return errors.Join(summaryErr, holdErr, d.deliverAll(deliveries, history))
+}
+
+// delivery is an event with the action to take for it.
+type delivery struct {
+	event  Event
+	action string
+}
+
+// holdBack turns what would pop up or ring into log entries for muted games
+// and while paused; paused ones are kept in the state directory for the
+// summary. d.mu must be held.
+func (d *Dispatcher) holdBack(deliveries []delivery) error {
+	paused := d.paused()
+	var held []Event
+	for i, dl := range deliveries {
+		if !intrusive(dl.action) || dl.event.Type == EventSummary {
+			continue
+		}
+		switch {
+		case d.muted[dl.event.MatchID]:
+			deliveries[i].action = data.NotifyActionLog
+		case paused:
+			deliveries[i].action = data.NotifyActionLog
+			held = append(held, dl.event)
+		}
+	}
+	return holdEvents(held)
+}
+
+// deliverAll records and delivers every delivery. Errors are returned joined
+// but do not stop other deliveries.
+func (d *Dispatcher) deliverAll(deliveries []delivery, history *History) error {
+	var errs []error
+	for _, dl := range deliveries {
+		if history != nil && dl.action != data.NotifyActionNone && dl.event.Type != EventSummary {
+			if err := history.Add(dl.event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if err := d.deliver(dl.event, dl.action); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// actionFor returns the action of the first event rule matching e.
+func (d *Dispatcher) actionFor(e Event, details *api.MatchDetails) string {
+	for _, rule := range d.rules {
+		if slices.Contains(rule.Events, string(e.Type)) && d.matches(rule, details, &e) {
+			return rule.Action
+		}
+	}
+	return d.defaultAction
+}
+
+// matches reports whether the game (and event, for event rules) meets every
+// condition set on the rule.
+func (d *Dispatcher) matches(rule data.NotificationRule, details *api.MatchDetails, e *Event) bool {
+	if len(rule.Teams) > 0 && !d.involvesTeam(rule.Teams, details) {
+		return false
+	}
+
+	home, away := 0, 0
+	if details.HomeScore != nil {
+		home = *details.HomeScore
+	}
+	if details.AwayScore != nil {
+		away = *details.AwayScore
+	}
+	if rule.MaxMargin != nil && abs(home-away) > *rule.MaxMargin {
+		return false
+	}
+
+	period := 0
+	if details.Quarter != nil {
+		period = *details.Quarter
+	}
+	if period < rule.MinPeriod {
+		return false
+	}
+
+	if rule.MaxClock != "" {
+		limit := clockSeconds(rule.MaxClock)
+		left := -1
+		if details.Clock != nil {
+			left = clockSeconds(*details.Clock)
+		}
+		if limit < 0 || left < 0 || left > limit {
+			return false
+		}
+	}
+
+	if rule.Player != "" || rule.MinPoints > 0 {
+		return playerMatches(rule, details, e)
+	}
+	return true
+}
+
+// involvesTeam reports whether either team is in teams ("favorites" expands to
+// the favorite teams).
+func (d *Dispatcher) involvesTeam(teams []string, details *api.MatchDetails) bool {
+	for _, t := range teams {
+		candidates := []string{t}
+		if strings.EqualFold(t, "favorites") {
+			candidates = d.favorites
+		}
+		for _, c := range candidates {
+			if strings.EqualFold(c, details.HomeTeam.ShortName) || strings.EqualFold(c, details.AwayTeam.ShortName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// playerMatches checks the player conditions: the event's player for event
+// rules, otherwise any box score line with the name and at least MinPoints.
+func playerMatches(rule data.NotificationRule, details *api.MatchDetails, e *Event) bool {
+	if e != nil && e.Player != "" && rule.MinPoints == 0 {
+		if samePlayer(rule.Player, e.Player) {
+			return true
+		}
+		// A scoring summary matches if the player scored any of its plays
+		for _, p := range e.Plays {
+			if p.Player != nil && samePlayer(rule.Player, *p.Player) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, lines := range [][]api.PlayerStatLine{details.HomePlayerStats, details.AwayPlayerStats} {
+		for _, p := range lines {
+			if (rule.Player == "" || samePlayer(rule.Player, p.Name)) && p.Points >= rule.MinPoints {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// samePlayer compares player names, accepting "J. Tatum" for "Jayson Tatum"
+// since play-by-play abbreviates first names.
+func samePlayer(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	fa, fb := strings.Fields(a), strings.Fields(b)
+	if len(fa) < 2 || len(fb) < 2 {
+		return false
+	}
+	// The first letter is a rune, for names such as "Šarić" or "Ömer"
+	ra, _ := utf8.DecodeRuneInString(fa[0])
+	rb, _ := utf8.DecodeRuneInString(fb[0])
+	return strings.EqualFold(fa[len(fa)-1], fb[len(fb)-1]) &&
+		strings.EqualFold(string(ra), string(rb))
+}
+
+// intrusive reports whether an action interrupts: a popup, a bell or a sink.
+func intrusive(action string) bool {
+	return action != data.NotifyActionLog && action != data.NotifyActionNone
+}
+
+// deliver performs action for e.
+func (d *Dispatcher) deliver(e Event, action string) error {
+	switch action {
+	case data.NotifyActionNone:
+		return nil
+	case data.NotifyActionBell:
+		_, err := os.Stderr.WriteString("\a")
+		return err
+	case data.NotifyActionLog:
+		return AppendLog(e)
+	default:
+		if d.notifier == nil {
+			return nil
+		}
+		return d.notifier.Notify(e)
+	}
+}
+
+// AppendLog appends one line for e to the notification log in the state directory.
+func AppendLog(e Event) error {
+	dir, err := data.StateDir()
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, logFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open notification log: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "%s [%s] %s | %s\n", e.Time.Format(time.RFC3339), e.Type, e.Headline(), e.Scoreline())
+	return err
+}
+
+// ruleKey identifies a rule by its content.
+func ruleKey(rule data.NotificationRule) string {
+	b, _ := json.Marshal(rule)
+	return string(b)
+}
+
+// ruleName returns the rule's name, or a description of its conditions.
+func ruleName(rule data.NotificationRule) string {
+	if rule.Name != "" {
+		return rule.Name
+	}
+	var parts []string
+	if rule.Player != "" {
+		parts = append(parts, rule.Player)
+	}
+	if rule.MinPoints > 0 {
+		parts = append(parts, fmt.Sprintf("%d+ points", rule.MinPoints))
+	}
+	if rule.MaxMargin != nil {
+		parts = append(parts, fmt.Sprintf("margin ≤ %d", *rule.MaxMargin))
+	}
+	if rule.MinPeriod > 0 {
+		parts = append(parts, PeriodLabel(rule.MinPeriod)+"+")
+	}
+	if rule.MaxClock != "" {
+		parts = append(parts, "under "+rule.MaxClock)
+	}
+	if len(parts) == 0 {
+		return "Rule matched"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
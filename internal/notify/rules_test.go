@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+func TestRuleMatches(t *testing.T) {
+	margin := 5
+	withStats := func(details *api.MatchDetails) *api.MatchDetails {
+		details.HomePlayerStats = []api.PlayerStatLine{{Name: "Jayson Tatum", Points: 31}}
+		details.AwayPlayerStats = []api.PlayerStatLine{{Name: "Bam Adebayo", Points: 12}}
+		return details
+	}
+	tests := []struct {
+		name    string
+		rule    data.NotificationRule
+		details *api.MatchDetails
+		event   *Event
+		want    bool
+	}{
+		{"no conditions", data.NotificationRule{}, testGame(api.MatchStatusLive, 1, "8:00", 10, 2), nil, true},
+		{"team plays", data.NotificationRule{Teams: []string{"mia"}}, testGame(api.MatchStatusLive, 1, "8:00", 10, 2), nil, true},
+		{"team not playing", data.NotificationRule{Teams: []string{"LAL"}}, testGame(api.MatchStatusLive, 1, "8:00", 10, 2), nil, false},
+		{"favorite plays", data.NotificationRule{Teams: []string{"favorites"}}, testGame(api.MatchStatusLive, 1, "8:00", 10, 2), nil, true},
+		{"margin within", data.NotificationRule{MaxMargin: &margin}, testGame(api.MatchStatusLive, 4, "3:00", 95, 90), nil, true},
+		{"margin too large", data.NotificationRule{MaxMargin: &margin}, testGame(api.MatchStatusLive, 4, "3:00", 96, 90), nil, false},
+		{"period reached", data.NotificationRule{MinPeriod: 4}, testGame(api.MatchStatusLive, 5, "3:00", 100, 100), nil, true},
+		{"period too early", data.NotificationRule{MinPeriod: 4}, testGame(api.MatchStatusLive, 3, "3:00", 70, 70), nil, false},
+		{"clock within", data.NotificationRule{MaxClock: "2:00"}, testGame(api.MatchStatusLive, 4, "2:00", 90, 90), nil, true},
+		{"clock too early", data.NotificationRule{MaxClock: "2:00"}, testGame(api.MatchStatusLive, 4, "2:01", 90, 90), nil, false},
+		{"clock unknown", data.NotificationRule{MaxClock: "2:00"}, testGame(api.MatchStatusNotStarted, 0, "", 0, 0), nil, false},
+		{"player scored the play", data.NotificationRule{Player: "Jayson Tatum"}, testGame(api.MatchStatusLive, 2, "5:00", 40, 38), &Event{Player: "J. Tatum"}, true},
+		{"other player scored", data.NotificationRule{Player: "Jayson Tatum"}, testGame(api.MatchStatusLive, 2, "5:00", 40, 38), &Event{Player: "B. Adebayo"}, false},
+		{"player reached points", data.NotificationRule{Player: "J. Tatum", MinPoints: 30}, withStats(testGame(api.MatchStatusLive, 3, "5:00", 80, 70)), nil, true},
+		{"player short of points", data.NotificationRule{Player: "Bam Adebayo", MinPoints: 30}, withStats(testGame(api.MatchStatusLive, 3, "5:00", 80, 70)), nil, false},
+		{"anyone reached points", data.NotificationRule{MinPoints: 30}, withStats(testGame(api.MatchStatusLive, 3, "5:00", 80, 70)), nil, true},
+	}
+	d := NewDispatcher(&recordingNotifier{}, &data.Settings{FavoriteTeams: []string{"BOS"}})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.matches(tt.rule, tt.details, tt.event); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActionFor(t *testing.T) {
+	settings := &data.Settings{}
+	settings.Notifications.DefaultAction = data.NotifyActionLog
+	settings.Notifications.Rules = []data.NotificationRule{
+		{Events: []string{"close_game"}, MinPeriod: 5, Action: data.NotifyActionNone},
+		{Events: []string{"close_game", "overtime"}, Action: data.NotifyActionBell},
+	}
+	d := NewDispatcher(&recordingNotifier{}, settings)
+	tests := []struct {
+		name   string
+		event  EventType
+		period int
+		want   string
+	}{
+		{"first rule", EventCloseGame, 5, data.NotifyActionNone},
+		{"second rule", EventCloseGame, 4, data.NotifyActionBell},
+		{"other event", EventOvertime, 5, data.NotifyActionBell},
+		{"no rule", EventFinal, 4, data.NotifyActionLog},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := testGame(api.MatchStatusLive, tt.period, "1:00", 100, 99)
+			if got := d.actionFor(Event{Type: tt.event}, details); got != tt.want {
+				t.Errorf("actionFor(%s) = %q, want %q", tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamePlayer(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"Jayson Tatum", "Jayson Tatum", true},
+		{"jayson tatum", "Jayson Tatum", true},
+		{"J. Tatum", "Jayson Tatum", true},
+		{"J. Brown", "Jayson Tatum", false},
+		{"K. Tatum", "Jayson Tatum", false},
+		{"Tatum", "Jayson Tatum", false},
+		{"Š. Šarić", "Šime Šarić", true},
+		{"Ž. Šarić", "Šime Šarić", false},
+		{"Ö. Yurtseven", "Ömer Yurtseven", true},
+	}
+	for _, tt := range tests {
+		if got := samePlayer(tt.a, tt.b); got != tt.want {
+			t.Errorf("samePlayer(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// Delivery defaults for sinks.
+const (
+	DefaultSinkTimeout  = 10 * time.Second       // per delivery attempt
+	DefaultRetryBackoff = 500 * time.Millisecond // wait before the first retry; doubles each retry
+)
+
+// WebhookNotifier POSTs each event to an HTTP endpoint, as JSON or as a body
+// rendered from a template (Slack, Discord, ntfy and similar services).
+type WebhookNotifier struct {
+	url      string
+	headers  map[string]string
+	template *template.Template
+	client   *http.Client
+}
+
+// NewWebhookNotifier creates a webhook notifier. body is an optional Go
+// template executed with the Event; its "json" function quotes a value as JSON,
+// e.g. {"text": {{json .Message}}}. Without a template the event JSON is sent.
+func NewWebhookNotifier(url string, headers map[string]string, body string, timeout time.Duration) (*WebhookNotifier, error) {
+	if url == "" {
+		return nil, errors.New("webhook url is required")
+	}
+	if timeout <= 0 {
+		timeout = DefaultSinkTimeout
+	}
+	n := &WebhookNotifier{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+	if body != "" {
+		tmpl, err := template.New("webhook").Funcs(template.FuncMap{"json": jsonString}).Parse(body)
+		if err != nil {
+			return nil, fmt.Errorf("parse webhook template: %w", err)
+		}
+		n.template = tmpl
+	}
+	return n, nil
+}
+
+// Notify posts the event. Any status outside 2xx is an error.
+func (n *WebhookNotifier) Notify(event Event) error {
+	body, contentType, err := n.body(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range n.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("post webhook: %s", resp.Status)
+	}
+	return nil
+}
+
+// body renders the request body and its content type: JSON unless a template
+// renders something else (ntfy takes plain text).
+func (n *WebhookNotifier) body(event Event) ([]byte, string, error) {
+	if n.template == nil {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return nil, "", fmt.Errorf("encode event: %w", err)
+		}
+		return b, "application/json", nil
+	}
+
+	var buf bytes.Buffer
+	if err := n.template.Execute(&buf, event); err != nil {
+		return nil, "", fmt.Errorf("render webhook template: %w", err)
+	}
+	if json.Valid(buf.Bytes()) {
+		return buf.Bytes(), "application/json", nil
+	}
+	return buf.Bytes(), "text/plain; charset=utf-8", nil
+}
+
+// jsonString quotes v as JSON for use inside templates.
+func jsonString(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+// execWaitDelay is how long an exec sink's output is waited for once its
+// command has exited or been killed, in case a child it started keeps the
+// output open.
+const execWaitDelay = 2 * time.Second
+
+// ExecNotifier runs a command for each event, with the event JSON on stdin and
+// COURTSIDE_EVENT, COURTSIDE_TITLE and COURTSIDE_MESSAGE in the environment.
+type ExecNotifier struct {
+	command []string
+	timeout time.Duration
+}
+
+// NewExecNotifier creates a notifier running command (program and arguments).
+func NewExecNotifier(command []string, timeout time.Duration) (*ExecNotifier, error) {
+	if len(command) == 0 || command[0] == "" {
+		return nil, errors.New("exec command is required")
+	}
+	if timeout <= 0 {
+		timeout = DefaultSinkTimeout
+	}
+	return &ExecNotifier{command: command, timeout: timeout}, nil
+}
+
+// Notify runs the command and waits for it; a non-zero exit or a timeout is an error.
+func (n *ExecNotifier) Notify(event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, n.command[0], n.command[1:]...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.WaitDelay = execWaitDelay
+	cmd.Env = append(os.Environ(),
+		"COURTSIDE_EVENT="+string(event.Type),
+		"COURTSIDE_TITLE="+event.Title(),
+		"COURTSIDE_MESSAGE="+event.Message(),
+	)
+
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("run %s: timed out after %s", n.command[0], n.timeout)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("run %s: %w: %s", n.command[0], err, truncate(msg, 200))
+		}
+		return fmt.Errorf("run %s: %w", n.command[0], err)
+	}
+	return nil
+}
+
+// Sink is one destination of a FanOut.
+type Sink struct {
+	Name     string
+	Notifier Notifier
+	Events   []EventType // event types sent to the sink; empty means all
+	Retries  int         // extra attempts after a failure
+}
+
+// accepts reports whether the sink wants events of type t.
+func (s Sink) accepts(t EventType) bool {
+	return len(s.Events) == 0 || slices.Contains(s.Events, t)
+}
+
+// FanOut sends each event to several sinks at once, retrying failed sinks with
+// exponential backoff. A failing sink does not stop delivery to the others.
+type FanOut struct {
+	sinks   []Sink
+	backoff time.Duration
+}
+
+// NewFanOut creates a fan-out notifier over sinks.
+func NewFanOut(sinks ...Sink) *FanOut {
+	return &FanOut{sinks: sinks, backoff: DefaultRetryBackoff}
+}
+
+// SetBackoff changes the wait before the first retry.
+func (f *FanOut) SetBackoff(d time.Duration) {
+	f.backoff = d
+}
+
+// Notify delivers event to every sink that accepts it and waits for all of
+// them. Errors of the sinks that still failed after their retries are joined.
+func (f *FanOut) Notify(event Event) error {
+	errs := make([]error, len(f.sinks))
+	var wg sync.WaitGroup
+	for i, s := range f.sinks {
+		if s.Notifier == nil || !s.accepts(event.Type) {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = f.send(s, event)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// send delivers event to one sink, retrying up to its retry count.
+func (f *FanOut) send(s Sink, event Event) error {
+	wait := f.backoff
+	var err error
+	for attempt := 0; attempt <= s.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(wait)
+			wait *= 2
+		}
+		if err = s.Notifier.Notify(event); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("sink %s: %w", s.Name, err)
+}
+
+// NewNotifier builds the notifier used by the desktop action: desktop plus
+// every sink configured in settings. Invalid sinks are skipped and reported in
+// the returned error; the notifier is usable either way.
+func NewNotifier(desktop Notifier, settings *data.Settings) (Notifier, error) {
+	if settings == nil || len(settings.Notifications.Sinks) == 0 {
+		return desktop, nil
+	}
+
+	sinks := []Sink{{Name: "desktop", Notifier: desktop}}
+	var errs []error
+	for i, cfg := range settings.Notifications.Sinks {
+		sink, err := sinkFromSettings(cfg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sink %d: %w", i+1, err))
+			continue
+		}
+		sinks = append(sinks, sink)
+	}
+	return NewFanOut(sinks...), errors.Join(errs...)
+}
+
+// sinkFromSettings creates the sink described by cfg.
+func sinkFromSettings(cfg data.NotificationSink) (Sink, error) {
+	timeout := DefaultSinkTimeout
+	if cfg.Timeout != "" {
+		d, err := time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return Sink{}, fmt.Errorf("invalid timeout %q: %w", cfg.Timeout, err)
+		}
+		timeout = d
+	}
+
+	sink := Sink{Name: cfg.Name, Retries: cfg.Retries}
+	for _, t := range cfg.Events {
+		sink.Events = append(sink.Events, EventType(t))
+	}
+
+	var err error
+	switch cfg.Type {
+	case data.SinkTypeWebhook:
+		sink.Notifier, err = NewWebhookNotifier(cfg.URL, cfg.Headers, cfg.Template, timeout)
+		// Not the URL: webhook URLs often embed a secret token
+		if sink.Name == "" {
+			sink.Name = cfg.Type
+		}
+	case data.SinkTypeExec:
+		sink.Notifier, err = NewExecNotifier(cfg.Command, timeout)
+		if sink.Name == "" && len(cfg.Command) > 0 {
+			sink.Name = cfg.Command[0]
+		}
+	default:
+		err = fmt.Errorf("unknown type %q", cfg.Type)
+	}
+	return sink, err
+}
+
+// truncate shortens s to at most n bytes.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,235 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+func testEvent() Event {
+	return Event{
+		Type:      EventFinal,
+		MatchID:   42,
+		HomeTeam:  api.Team{ID: 1, ShortName: "BOS"},
+		AwayTeam:  api.Team{ID: 2, ShortName: "MIA"},
+		HomeScore: 110,
+		AwayScore: 104,
+		Period:    4,
+	}
+}
+
+func TestWebhookNotifierJSON(t *testing.T) {
+	var got Event
+	var contentType, auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType, auth = r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	n, err := NewWebhookNotifier(srv.URL, map[string]string{"Authorization": "Bearer token"}, "", time.Second)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier: %v", err)
+	}
+	if err := n.Notify(testEvent()); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	if got.Type != EventFinal || got.MatchID != 42 || got.HomeScore != 110 {
+		t.Errorf("server got %+v; want the final of match 42", got)
+	}
+	if contentType != "application/json" {
+		t.Errorf("Content-Type = %q; want application/json", contentType)
+	}
+	if auth != "Bearer token" {
+		t.Errorf("Authorization = %q; want the configured header", auth)
+	}
+}
+
+func TestWebhookNotifierTemplate(t *testing.T) {
+	tests := []struct {
+		template        string
+		wantBody        string
+		wantContentType string
+	}{
+		{`{"text": {{json .Headline}}}`, `{"text": "Final · BOS win"}`, "application/json"},
+		{`{{.Scoreline}}`, "BOS 110 - 104 MIA", "text/plain; charset=utf-8"},
+	}
+
+	for _, tt := range tests {
+		var body, contentType string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			body, contentType = string(b), r.Header.Get("Content-Type")
+		}))
+
+		n, err := NewWebhookNotifier(srv.URL, nil, tt.template, time.Second)
+		if err != nil {
+			t.Fatalf("NewWebhookNotifier(%q): %v", tt.template, err)
+		}
+		if err := n.Notify(testEvent()); err != nil {
+			t.Errorf("Notify with %q: %v", tt.template, err)
+		}
+		srv.Close()
+
+		if body != tt.wantBody || contentType != tt.wantContentType {
+			t.Errorf("template %q sent %q (%s); want %q (%s)", tt.template, body, contentType, tt.wantBody, tt.wantContentType)
+		}
+	}
+}
+
+func TestWebhookNotifierTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	n, _ := NewWebhookNotifier(srv.URL, nil, "", 50*time.Millisecond)
+	start := time.Now()
+	if err := n.Notify(testEvent()); err == nil {
+		t.Errorf("Notify to a hanging server succeeded; want a timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Notify took %s; want it bounded by the timeout", elapsed)
+	}
+}
+
+func TestFanOutRetry(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail twice, then accept
+		if calls.Add(1) <= 2 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	webhook, _ := NewWebhookNotifier(srv.URL, nil, "", time.Second)
+	f := NewFanOut(Sink{Name: "webhook", Notifier: webhook, Retries: 2})
+	f.SetBackoff(time.Millisecond)
+
+	if err := f.Notify(testEvent()); err != nil {
+		t.Errorf("Notify: %v; want success on the third attempt", err)
+	}
+	if n := calls.Load(); n != 3 {
+		t.Errorf("server got %d requests; want 3", n)
+	}
+}
+
+type recordingNotifier struct {
+	events []Event
+	err    error
+}
+
+func (r *recordingNotifier) Notify(e Event) error {
+	r.events = append(r.events, e)
+	return r.err
+}
+
+func TestFanOutIsolatesFailures(t *testing.T) {
+	ok := &recordingNotifier{}
+	failing := &recordingNotifier{err: errors.New("boom")}
+	finalsOnly := &recordingNotifier{}
+
+	f := NewFanOut(
+		Sink{Name: "ok", Notifier: ok},
+		Sink{Name: "failing", Notifier: failing, Retries: 1},
+		Sink{Name: "finals", Notifier: finalsOnly, Events: []EventType{EventFinal}},
+	)
+	f.SetBackoff(time.Millisecond)
+
+	e := testEvent()
+	e.Type = EventLeadChange
+	err := f.Notify(e)
+	if err == nil || !strings.Contains(err.Error(), "sink failing: boom") {
+		t.Errorf("Notify error = %v; want the failing sink's error", err)
+	}
+	if len(ok.events) != 1 {
+		t.Errorf("ok sink got %d events; want 1", len(ok.events))
+	}
+	if len(failing.events) != 2 {
+		t.Errorf("failing sink got %d attempts; want 2", len(failing.events))
+	}
+	if len(finalsOnly.events) != 0 {
+		t.Errorf("finals-only sink got a lead change")
+	}
+}
+
+func TestExecNotifier(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	n, _ := NewExecNotifier([]string{"sh", "-c", `grep -q '"type":"final"' && test "$COURTSIDE_EVENT" = final`}, time.Second)
+	if err := n.Notify(testEvent()); err != nil {
+		t.Errorf("Notify: %v; want the event JSON on stdin and in the environment", err)
+	}
+
+	n, _ = NewExecNotifier([]string{"sh", "-c", "echo nope >&2; exit 3"}, time.Second)
+	if err := n.Notify(testEvent()); err == nil || !strings.Contains(err.Error(), "nope") {
+		t.Errorf("Notify error = %v; want the command's output", err)
+	}
+
+	n, _ = NewExecNotifier([]string{"sleep", "5"}, 50*time.Millisecond)
+	if err := n.Notify(testEvent()); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Notify error = %v; want a timeout", err)
+	}
+
+	// A child left running with the output open doesn't hold up the timeout
+	n, _ = NewExecNotifier([]string{"sh", "-c", "sleep 10 & sleep 5"}, 50*time.Millisecond)
+	start := time.Now()
+	if err := n.Notify(testEvent()); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Notify error = %v; want a timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > execWaitDelay+time.Second {
+		t.Errorf("Notify took %s; want it back within the wait delay of %s", elapsed, execWaitDelay)
+	}
+}
+
+func TestNewNotifierFromSettings(t *testing.T) {
+	desktop := &recordingNotifier{}
+
+	n, err := NewNotifier(desktop, &data.Settings{})
+	if err != nil || n != Notifier(desktop) {
+		t.Errorf("NewNotifier without sinks = %v, %v; want the desktop notifier", n, err)
+	}
+
+	settings := &data.Settings{Notifications: data.NotificationSettings{Sinks: []data.NotificationSink{
+		{Type: data.SinkTypeWebhook, URL: "http://127.0.0.1:1/hook"},
+		{Type: "carrier-pigeon"},
+		{Type: data.SinkTypeExec, Timeout: "soon", Command: []string{"true"}},
+	}}}
+	n, err = NewNotifier(desktop, settings)
+	if err == nil || !strings.Contains(err.Error(), "sink 2") || !strings.Contains(err.Error(), "sink 3") {
+		t.Errorf("NewNotifier error = %v; want sinks 2 and 3 reported", err)
+	}
+	fan, ok := n.(*FanOut)
+	if !ok || len(fan.sinks) != 2 {
+		t.Fatalf("NewNotifier = %T; want a fan-out over desktop and the webhook", n)
+	}
+}
+
+func TestSettingsNameEveryEventType(t *testing.T) {
+	if len(data.NotifyEvents) != len(EventTypes) {
+		t.Fatalf("data.NotifyEvents has %d event types, EventTypes %d", len(data.NotifyEvents), len(EventTypes))
+	}
+	for i, eventType := range EventTypes {
+		if data.NotifyEvents[i] != string(eventType) {
+			t.Errorf("data.NotifyEvents[%d] = %q, want %q", i, data.NotifyEvents[i], eventType)
+		}
+	}
+}
@@ -0,0 +1,401 @@
+package notify
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/nba"
+)
+
+// Thresholds for momentum events.
+const (
+	BigRunPoints     = 10 // unanswered points for EventBigRun
+	CloseGameMargin  = 5  // max margin for EventCloseGame
+	CloseGameSeconds = 300
+)
+
+// gameState is what the tracker remembers about a game between snapshots.
+type gameState struct {
+	status      api.MatchStatus
+	period      int
+	periodOver  int // last period whose end was reported
+	homeScore   int
+	awayScore   int
+	leader      int                    // 1 = home, -1 = away, 0 = nobody has led yet; ties keep the last leader
+	closePeriod int                    // last period a close-game event fired in
+	runStartID  int                    // first play of the last run reported
+	scoring     map[int]api.MatchEvent // scoring plays by ID
+	ejections   map[int]bool
+	milestones  map[string]bool // "player:detail" already reported
+}
+
+// Tracker turns successive snapshots of live games into typed events.
+// It is safe for concurrent use, so one tracker can watch several games.
+type Tracker struct {
+	mu    sync.Mutex
+	games map[int]*gameState
+}
+
+// NewTracker creates an empty tracker.
+func NewTracker() *Tracker {
+	return &Tracker{games: make(map[int]*gameState)}
+}
+
+// Reset forgets every game, so the next snapshot of each only sets a baseline.
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.games = make(map[int]*gameState)
+}
+
+// Observe compares details with the previous snapshot of the same game and
+// returns the events that happened in between, in the order they read best.
+// The first snapshot of a game only sets the baseline and returns nothing.
+func (t *Tracker) Observe(details *api.MatchDetails) []Event {
+	if details == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cur := snapshot(details)
+	prev, ok := t.games[details.ID]
+	if !ok {
+		t.games[details.ID] = cur
+		return nil
+	}
+
+	var events []Event
+	emit := func(typ EventType, fill func(*Event)) {
+		e := newEvent(typ, details)
+		if fill != nil {
+			fill(&e)
+		}
+		events = append(events, e)
+	}
+
+	if prev.status == api.MatchStatusNotStarted && cur.status == api.MatchStatusLive {
+		emit(EventTipOff, nil)
+	}
+
+	if fix := scoreCorrection(prev, cur, details); fix != "" {
+		emit(EventCorrection, func(e *Event) { e.Detail = fix })
+	}
+
+	// Every basket since the last poll goes into one notification
+	scored := cur.homeScore > prev.homeScore || cur.awayScore > prev.awayScore
+	if plays := newScoringPlays(details, prev.scoring); len(plays) > 0 {
+		emit(EventScore, func(e *Event) {
+			last := plays[len(plays)-1]
+			e.Plays, e.Play, e.Team = plays, &last, &last.Team
+			if last.Player != nil {
+				e.Player = *last.Player
+			}
+		})
+	} else if scored && len(details.Events) == 0 {
+		// No play-by-play to describe the baskets; with play-by-play they may
+		// just not be in yet and will show up next poll
+		emit(EventScore, func(e *Event) { e.Detail = scoreChange(prev, cur, details) })
+	}
+
+	// Lead changes: ties keep the previous leader so A → tie → B still counts
+	cur.leader = prev.leader
+	if lead := sign(cur.homeScore - cur.awayScore); lead != 0 {
+		if prev.leader != 0 && lead != prev.leader && cur.status == api.MatchStatusLive {
+			emit(EventLeadChange, func(e *Event) { e.Team = teamOf(details, lead) })
+		}
+		cur.leader = lead
+	}
+
+	cur.runStartID = prev.runStartID
+	if team, points, startID := currentRun(details.Events); points >= BigRunPoints && startID != prev.runStartID {
+		emit(EventBigRun, func(e *Event) {
+			e.Team = teamByID(details, team)
+			e.Detail = fmt.Sprintf("%d-0", points)
+		})
+		cur.runStartID = startID
+	}
+
+	cur.ejections = prev.ejections
+	for _, ev := range details.Events {
+		if ev.Type != "ejection" || cur.ejections[ev.ID] {
+			continue
+		}
+		cur.ejections[ev.ID] = true
+		emit(EventEjection, func(e *Event) {
+			e.Team = teamByID(details, ev.Team.ID)
+			if ev.Player != nil {
+				e.Player = *ev.Player
+			}
+		})
+	}
+
+	cur.milestones = prev.milestones
+	for _, m := range nba.NewMilestones(details, cur.milestones) {
+		emit(EventMilestone, func(e *Event) {
+			e.Team, e.Player, e.Detail = &m.Team, m.Player, m.Detail
+		})
+	}
+
+	cur.periodOver = prev.periodOver
+	if ended := endedPeriod(details); ended > prev.periodOver {
+		switch ended {
+		case 1, 3:
+			emit(EventEndOfPeriod, func(e *Event) { e.Period = ended })
+		case 2:
+			emit(EventHalftime, func(e *Event) { e.Period = ended })
+		}
+		cur.periodOver = ended
+	}
+
+	// An overtime is close by definition; it gets its own event instead
+	cur.closePeriod = prev.closePeriod
+	if cur.period >= 5 && cur.period > prev.period && cur.status == api.MatchStatusLive {
+		emit(EventOvertime, nil)
+		cur.closePeriod = cur.period
+	}
+
+	if isCloseGame(details, cur) && cur.period > cur.closePeriod {
+		emit(EventCloseGame, nil)
+		cur.closePeriod = cur.period
+	}
+
+	if prev.status == api.MatchStatusLive && cur.status == api.MatchStatusFinished {
+		emit(EventFinal, nil)
+		// Nothing else happens after the final; a later snapshot starts over
+		delete(t.games, details.ID)
+		return events
+	}
+
+	t.games[details.ID] = cur
+	return events
+}
+
+// snapshot captures the parts of details the tracker compares.
+func snapshot(details *api.MatchDetails) *gameState {
+	s := &gameState{
+		status:     details.Status,
+		scoring:    scoringPlays(details),
+		ejections:  make(map[int]bool),
+		milestones: make(map[string]bool),
+	}
+	if details.Quarter != nil {
+		s.period = *details.Quarter
+	}
+	if details.HomeScore != nil {
+		s.homeScore = *details.HomeScore
+	}
+	if details.AwayScore != nil {
+		s.awayScore = *details.AwayScore
+	}
+	s.leader = sign(s.homeScore - s.awayScore)
+	s.periodOver = endedPeriod(details)
+	if _, points, startID := currentRun(details.Events); points >= BigRunPoints {
+		s.runStartID = startID
+	}
+	for _, ev := range details.Events {
+		if ev.Type == "ejection" {
+			s.ejections[ev.ID] = true
+		}
+	}
+	nba.NewMilestones(details, s.milestones)
+	return s
+}
+
+// newEvent creates an event of type typ carrying the game's current state.
+func newEvent(typ EventType, details *api.MatchDetails) Event {
+	e := Event{
+		Type:      typ,
+		MatchID:   details.ID,
+		MatchTime: details.MatchTime,
+		HomeTeam:  details.HomeTeam,
+		AwayTeam:  details.AwayTeam,
+		Time:      time.Now(),
+	}
+	if details.HomeScore != nil {
+		e.HomeScore = *details.HomeScore
+	}
+	if details.AwayScore != nil {
+		e.AwayScore = *details.AwayScore
+	}
+	if details.Quarter != nil {
+		e.Period = *details.Quarter
+	}
+	if details.Clock != nil {
+		e.Clock = *details.Clock
+	}
+	return e
+}
+
+// scoringPlays returns the game's scoring plays by ID, with the full team set.
+func scoringPlays(details *api.MatchDetails) map[int]api.MatchEvent {
+	plays := make(map[int]api.MatchEvent)
+	for _, ev := range details.Events {
+		switch strings.ToLower(ev.Type) {
+		case "goal", "field_goal", "free_throw":
+		default:
+			continue
+		}
+		if ev.Points != nil && *ev.Points == 0 {
+			continue
+		}
+		// Play-by-play only carries the team ID
+		ev.Team = *teamByID(details, ev.Team.ID)
+		plays[ev.ID] = ev
+	}
+	return plays
+}
+
+// newScoringPlays returns the scoring plays not in seen, oldest first.
+func newScoringPlays(details *api.MatchDetails, seen map[int]api.MatchEvent) []api.MatchEvent {
+	var plays []api.MatchEvent
+	for id, ev := range scoringPlays(details) {
+		if _, ok := seen[id]; !ok {
+			plays = append(plays, ev)
+		}
+	}
+	slices.SortFunc(plays, func(a, b api.MatchEvent) int { return a.ID - b.ID })
+	return plays
+}
+
+// scoreCorrection describes a score that went down between snapshots, such as
+// a basket taken back after review ("BOS 89 → 87 (J. Tatum 2PT removed)").
+// It is empty when no score went down.
+func scoreCorrection(prev, cur *gameState, details *api.MatchDetails) string {
+	var parts []string
+	if cur.homeScore < prev.homeScore {
+		parts = append(parts, fmt.Sprintf("%s %d → %d", teamLabel(details.HomeTeam), prev.homeScore, cur.homeScore))
+	}
+	if cur.awayScore < prev.awayScore {
+		parts = append(parts, fmt.Sprintf("%s %d → %d", teamLabel(details.AwayTeam), prev.awayScore, cur.awayScore))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+
+	var removed []api.MatchEvent
+	for id, ev := range prev.scoring {
+		if _, ok := cur.scoring[id]; !ok {
+			removed = append(removed, ev)
+		}
+	}
+	slices.SortFunc(removed, func(a, b api.MatchEvent) int { return a.ID - b.ID })
+	var plays []string
+	for _, ev := range removed {
+		plays = append(plays, playSummary(ev))
+	}
+
+	fix := strings.Join(parts, ", ")
+	if len(plays) > 0 {
+		fix += " (" + strings.Join(plays, ", ") + " removed)"
+	}
+	return fix
+}
+
+// scoreChange describes the points each team scored between snapshots ("BOS +3").
+func scoreChange(prev, cur *gameState, details *api.MatchDetails) string {
+	var parts []string
+	if d := cur.homeScore - prev.homeScore; d > 0 {
+		parts = append(parts, fmt.Sprintf("%s +%d", teamLabel(details.HomeTeam), d))
+	}
+	if d := cur.awayScore - prev.awayScore; d > 0 {
+		parts = append(parts, fmt.Sprintf("%s +%d", teamLabel(details.AwayTeam), d))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// currentRun returns the team on the current scoring run, its unanswered points
+// and the ID of the run's first play.
+func currentRun(events []api.MatchEvent) (teamID, points, startID int) {
+	for i := len(events) - 1; i >= 0; i-- {
+		ev := events[i]
+		if ev.Points == nil || *ev.Points == 0 {
+			continue
+		}
+		if teamID == 0 {
+			teamID = ev.Team.ID
+		} else if ev.Team.ID != teamID {
+			break
+		}
+		points += *ev.Points
+		startID = ev.ID
+	}
+	return teamID, points, startID
+}
+
+// endedPeriod returns the last period known to be over: the current one when
+// the clock has run out or the status says so ("End of 1st Qtr", "Halftime"),
+// otherwise the one before it.
+func endedPeriod(details *api.MatchDetails) int {
+	if details.Quarter == nil || details.Status != api.MatchStatusLive {
+		return 0
+	}
+	period := *details.Quarter
+	if details.Clock != nil && clockSeconds(*details.Clock) == 0 {
+		return period
+	}
+	if details.LiveTime != nil {
+		status := strings.ToLower(*details.LiveTime)
+		if strings.HasPrefix(status, "end") || strings.HasPrefix(status, "half") {
+			return period
+		}
+	}
+	return period - 1
+}
+
+// isCloseGame reports whether a live game is within CloseGameMargin points
+// inside the last CloseGameSeconds of the fourth quarter or an overtime.
+func isCloseGame(details *api.MatchDetails, s *gameState) bool {
+	if s.status != api.MatchStatusLive || s.period < 4 || details.Clock == nil {
+		return false
+	}
+	left := clockSeconds(*details.Clock)
+	if left <= 0 || left > CloseGameSeconds {
+		return false
+	}
+	margin := s.homeScore - s.awayScore
+	return margin >= -CloseGameMargin && margin <= CloseGameMargin
+}
+
+// clockSeconds parses a game clock such as "2:34"; -1 if it cannot be parsed.
+func clockSeconds(clock string) int {
+	var minutes, seconds int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &minutes, &seconds); err != nil {
+		return -1
+	}
+	return minutes*60 + seconds
+}
+
+// teamOf returns the home team for side 1 and the away team for side -1.
+func teamOf(details *api.MatchDetails, side int) *api.Team {
+	if side > 0 {
+		return &details.HomeTeam
+	}
+	return &details.AwayTeam
+}
+
+// teamByID returns the game's team with the given ID, or a bare team.
+func teamByID(details *api.MatchDetails, id int) *api.Team {
+	switch id {
+	case details.HomeTeam.ID:
+		return &details.HomeTeam
+	case details.AwayTeam.ID:
+		return &details.AwayTeam
+	}
+	return &api.Team{ID: id}
+}
+
+// sign returns 1, -1 or 0.
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
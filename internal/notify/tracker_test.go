@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gabriel7419/courtside/internal/api"
+)
+
+var (
+	testHome = api.Team{ID: 1, ShortName: "BOS"}
+	testAway = api.Team{ID: 2, ShortName: "MIA"}
+)
+
+// testGame returns a snapshot of game 42 with the given plays.
+func testGame(status api.MatchStatus, period int, clock string, home, away int, plays ...api.MatchEvent) *api.MatchDetails {
+	details := &api.MatchDetails{Match: api.Match{
+		ID:        42,
+		HomeTeam:  testHome,
+		AwayTeam:  testAway,
+		Status:    status,
+		HomeScore: &home,
+		AwayScore: &away,
+	}}
+	if period > 0 {
+		details.Quarter, details.Clock = &period, &clock
+	}
+	details.Events = plays
+	return details
+}
+
+// basket returns a scoring play of team worth points.
+func basket(id int, team api.Team, points int) api.MatchEvent {
+	player := "J. Tatum"
+	return api.MatchEvent{ID: id, Type: "field_goal", Team: api.Team{ID: team.ID}, Player: &player, Points: &points}
+}
+
+func TestTrackerObserve(t *testing.T) {
+	live, scheduled, finished := api.MatchStatusLive, api.MatchStatusNotStarted, api.MatchStatusFinished
+	tests := []struct {
+		name string
+		prev *api.MatchDetails
+		cur  *api.MatchDetails
+		want []EventType
+	}{
+		{
+			name: "tip-off",
+			prev: testGame(scheduled, 0, "", 0, 0),
+			cur:  testGame(live, 1, "12:00", 0, 0),
+			want: []EventType{EventTipOff},
+		},
+		{
+			name: "first basket",
+			prev: testGame(live, 1, "11:00", 0, 0),
+			cur:  testGame(live, 1, "10:40", 2, 0, basket(1, testHome, 2)),
+			want: []EventType{EventScore},
+		},
+		{
+			name: "lead change",
+			prev: testGame(live, 1, "10:40", 2, 0, basket(1, testHome, 2)),
+			cur:  testGame(live, 1, "10:10", 2, 3, basket(1, testHome, 2), basket(2, testAway, 3)),
+			want: []EventType{EventScore, EventLeadChange},
+		},
+		{
+			name: "score taken back",
+			prev: testGame(live, 1, "9:00", 10, 8, basket(1, testHome, 2)),
+			cur:  testGame(live, 1, "8:50", 8, 8),
+			want: []EventType{EventCorrection},
+		},
+		{
+			name: "end of the first quarter",
+			prev: testGame(live, 1, "0:30", 20, 18),
+			cur:  testGame(live, 1, "0:00", 20, 18),
+			want: []EventType{EventEndOfPeriod},
+		},
+		{
+			name: "halftime",
+			prev: testGame(live, 2, "1:00", 50, 48),
+			cur:  testGame(live, 2, "0:00", 50, 48),
+			want: []EventType{EventHalftime},
+		},
+		{
+			name: "close game late in the fourth",
+			prev: testGame(live, 4, "6:00", 90, 88),
+			cur:  testGame(live, 4, "4:59", 90, 88),
+			want: []EventType{EventCloseGame},
+		},
+		{
+			name: "not close with ten to play",
+			prev: testGame(live, 4, "10:00", 90, 70),
+			cur:  testGame(live, 4, "4:59", 90, 70),
+		},
+		{
+			name: "overtime is not also a close game",
+			prev: testGame(live, 4, "0:00", 100, 100),
+			cur:  testGame(live, 5, "5:00", 100, 100),
+			want: []EventType{EventOvertime},
+		},
+		{
+			name: "final",
+			prev: testGame(live, 4, "0:05", 100, 98),
+			cur:  testGame(finished, 4, "0:00", 100, 98),
+			want: []EventType{EventFinal},
+		},
+		{
+			name: "nothing happened",
+			prev: testGame(live, 3, "5:00", 70, 60),
+			cur:  testGame(live, 3, "4:30", 70, 60),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewTracker()
+			if events := tracker.Observe(tt.prev); events != nil {
+				t.Fatalf("first Observe() = %v, want only a baseline", events)
+			}
+			var got []EventType
+			for _, e := range tracker.Observe(tt.cur) {
+				got = append(got, e.Type)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Observe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
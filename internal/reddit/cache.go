@@ -12,8 +12,8 @@ import (
 )
 
 const (
-	goalLinksFileName = "goal_links.json"
-	// CacheTTL defines how long goal links are stored.
+	highlightsFileName = "highlights.json"
+	// CacheTTL defines how long highlight links are stored.
 	// 7 days keeps the cache file small while covering recent matches.
 	CacheTTL = 7 * 24 * time.Hour // 7 days
 	// NotFoundTTL defines how long to cache "not found" results.
@@ -23,23 +23,23 @@ const (
 	NotFoundMarker = "__NOT_FOUND__"
 )
 
-// GoalLinkCache provides persistent storage for goal replay links.
-type GoalLinkCache struct {
+// HighlightCache provides persistent storage for play highlight links.
+type HighlightCache struct {
 	mu       sync.RWMutex
-	links    map[string]GoalLink // key: "matchID:minute"
+	links    map[string]Highlight // key: PlayKey.String()
 	filePath string
 }
 
-// NewGoalLinkCache creates a new cache, loading existing data from disk.
-func NewGoalLinkCache() (*GoalLinkCache, error) {
-	dir, err := data.ConfigDir()
+// NewHighlightCache creates a new cache, loading existing data from disk.
+func NewHighlightCache() (*HighlightCache, error) {
+	dir, err := data.CacheDir()
 	if err != nil {
-		return nil, fmt.Errorf("get config dir: %w", err)
+		return nil, fmt.Errorf("get cache dir: %w", err)
 	}
 
-	cache := &GoalLinkCache{
-		links:    make(map[string]GoalLink),
-		filePath: filepath.Join(dir, goalLinksFileName),
+	cache := &HighlightCache{
+		links:    make(map[string]Highlight),
+		filePath: filepath.Join(dir, highlightsFileName),
 	}
 
 	// Load existing cache from disk (silently ignore errors - start with empty cache)
@@ -51,20 +51,14 @@ func NewGoalLinkCache() (*GoalLinkCache, error) {
 	return cache, nil
 }
 
-// makeKey creates a cache key from matchID and minute.
-func makeKey(key GoalLinkKey) string {
-	return fmt.Sprintf("%d:%d", key.MatchID, key.Minute)
-}
-
-// Get retrieves a goal link from cache if it exists and is not expired.
+// Get retrieves a highlight link from cache if it exists and is not expired.
 // Returns nil if not cached or expired.
-// To distinguish "not found" from "not cached", use Exists().
-func (c *GoalLinkCache) Get(key GoalLinkKey) *GoalLink {
+// To distinguish "not found" from a link, use IsNotFound().
+func (c *HighlightCache) Get(key PlayKey) *Highlight {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	cacheKey := makeKey(key)
-	link, ok := c.links[cacheKey]
+	link, ok := c.links[key.String()]
 	if !ok {
 		return nil
 	}
@@ -87,38 +81,58 @@ func (c *GoalLinkCache) Get(key GoalLinkKey) *GoalLink {
 }
 
 // IsNotFound returns true if the cached entry is a "not found" marker.
-func IsNotFound(link *GoalLink) bool {
+func IsNotFound(link *Highlight) bool {
 	return link != nil && link.URL == NotFoundMarker
 }
 
 // SetNotFound stores a "not found" marker in the cache.
-// This prevents re-fetching goals that weren't found on Reddit.
-func (c *GoalLinkCache) SetNotFound(matchID, minute int) error {
-	return c.Set(GoalLink{
-		MatchID:   matchID,
-		Minute:    minute,
+// This prevents re-fetching plays that weren't found on Reddit.
+func (c *HighlightCache) SetNotFound(key PlayKey) error {
+	return c.Set(Highlight{
+		MatchID:   key.MatchID,
+		EventID:   key.EventID,
+		Period:    key.Period,
+		Clock:     key.Clock,
 		URL:       NotFoundMarker,
 		FetchedAt: time.Now(),
 	})
 }
 
-// Set stores a goal link in the cache and persists to disk.
-func (c *GoalLinkCache) Set(link GoalLink) error {
+// ClearNotFound removes the "not found" markers of keys, so that the plays
+// are searched again on the next lookup. Links that were found are kept.
+func (c *HighlightCache) ClearNotFound(keys ...PlayKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cleared := false
+	for _, key := range keys {
+		if link, ok := c.links[key.String()]; ok && link.URL == NotFoundMarker {
+			delete(c.links, key.String())
+			cleared = true
+		}
+	}
+	if !cleared {
+		return nil
+	}
+	return c.saveLocked()
+}
+
+// Set stores a highlight link in the cache and persists to disk.
+func (c *HighlightCache) Set(link Highlight) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
-	c.links[key] = link
+	c.links[link.Key().String()] = link
 
 	return c.saveLocked()
 }
 
-// All returns all cached goal links for a match.
-func (c *GoalLinkCache) All(matchID int) []GoalLink {
+// All returns all cached highlight links for a match.
+func (c *HighlightCache) All(matchID int) []Highlight {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	var result []GoalLink
+	var result []Highlight
 	for _, link := range c.links {
 		if link.MatchID == matchID && time.Since(link.FetchedAt) <= CacheTTL {
 			result = append(result, link)
@@ -127,18 +141,18 @@ func (c *GoalLinkCache) All(matchID int) []GoalLink {
 	return result
 }
 
-// Clear removes all cached goal links.
-func (c *GoalLinkCache) Clear() error {
+// Clear removes all cached highlight links.
+func (c *HighlightCache) Clear() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.links = make(map[string]GoalLink)
+	c.links = make(map[string]Highlight)
 	return c.saveLocked()
 }
 
 // CleanExpired removes expired entries from the cache.
 // Uses different TTLs for regular links vs "not found" markers.
-func (c *GoalLinkCache) CleanExpired() error {
+func (c *HighlightCache) CleanExpired() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -168,7 +182,7 @@ func (c *GoalLinkCache) CleanExpired() error {
 }
 
 // load reads the cache from disk.
-func (c *GoalLinkCache) load() error {
+func (c *HighlightCache) load() error {
 	data, err := os.ReadFile(c.filePath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -177,24 +191,23 @@ func (c *GoalLinkCache) load() error {
 		return fmt.Errorf("read cache file: %w", err)
 	}
 
-	var links []GoalLink
+	var links []Highlight
 	if err := json.Unmarshal(data, &links); err != nil {
 		return fmt.Errorf("parse cache file: %w", err)
 	}
 
 	// Convert to map
 	for _, link := range links {
-		key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
-		c.links[key] = link
+		c.links[link.Key().String()] = link
 	}
 
 	return nil
 }
 
 // saveLocked persists the cache to disk (must hold write lock).
-func (c *GoalLinkCache) saveLocked() error {
+func (c *HighlightCache) saveLocked() error {
 	// Convert map to slice for JSON
-	links := make([]GoalLink, 0, len(c.links))
+	links := make([]Highlight, 0, len(c.links))
 	for _, link := range c.links {
 		links = append(links, link)
 	}
@@ -211,8 +224,8 @@ func (c *GoalLinkCache) saveLocked() error {
 	return nil
 }
 
-// Size returns the number of cached goal links.
-func (c *GoalLinkCache) Size() int {
+// Size returns the number of cached highlight links.
+func (c *HighlightCache) Size() int {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	return len(c.links)
@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,15 +10,27 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
 )
 
 // DebugLogger is a function type for debug logging
 type DebugLogger func(message string)
 
+// ErrBlocked is returned when Reddit rate limits the requests or answers with
+// its CAPTCHA page instead of JSON.
+var ErrBlocked = errors.New("reddit is blocking requests")
+
+// BlockedBackoff is how long the client stops calling Reddit once it is
+// blocking requests.
+const BlockedBackoff = 5 * time.Minute
+
 // Fetcher defines the interface for fetching data from Reddit.
-// Uses Reddit's public JSON API for goal link retrieval.
+// Implemented by PublicJSONFetcher and OAuthFetcher.
 type Fetcher interface {
 	Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error)
+	SearchFlair(flair, query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error)
+	Comments(postURL string, limit int) ([]Comment, error)
 }
 
 // PublicJSONFetcher uses Reddit's public JSON endpoints (no auth required).
@@ -59,8 +72,8 @@ func NewPublicJSONFetcher() *PublicJSONFetcher {
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
-		// Reddit requires a descriptive User-Agent
-		userAgent:   "golazo:v1.0.0 (by /u/golazo_app)",
+		// Reddit requires a descriptive User-Agent, in the same form as the OAuth one
+		userAgent:   "courtside:public:v1 (by /u/courtside_app)",
 		rateLimiter: newRateLimiter(10), // 10 requests per minute for public API
 	}
 }
@@ -69,8 +82,24 @@ func NewPublicJSONFetcher() *PublicJSONFetcher {
 // matchTime is used to filter results to posts created around the game date.
 // sort controls the result ordering (e.g., "relevance", "top", "new", "hot").
 func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
-	f.rateLimiter.wait()
+	return f.SearchFlair("Highlight", query, limit, matchTime, sort)
+}
 
+// SearchFlair performs a search on r/nba for posts with the given flair
+// ("Highlight", "Game Thread"...) matching the query, like Search.
+func (f *PublicJSONFetcher) SearchFlair(flair, query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	searchURL := "https://www.reddit.com/r/nba/search.json?" + searchQuery(flair, query, limit, matchTime, sort)
+
+	var searchResp redditSearchResponse
+	if err := f.getJSON(searchURL, &searchResp); err != nil {
+		return nil, err
+	}
+	return searchResp.withFlair(flair), nil
+}
+
+// searchQuery returns the query string of an r/nba search for posts with the
+// given flair, created on the game's day.
+func searchQuery(flair, query string, limit int, matchTime time.Time, sort string) string {
 	// Build timestamp range for filtering (game day only ±12 hours)
 	startTime := matchTime.Add(-12 * time.Hour).Unix()
 	endTime := matchTime.Add(12 * time.Hour).Unix()
@@ -79,62 +108,79 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 		sort = "relevance"
 	}
 
-	// Build search URL for r/nba with Highlight flair filter and timestamp
 	// Reddit CloudSearch supports timestamp:START..END syntax
-	searchURL := fmt.Sprintf(
-		"https://www.reddit.com/r/nba/search.json?q=%s+flair:Highlight+timestamp:%d..%d&restrict_sr=on&sort=%s&limit=%d",
-		url.QueryEscape(query),
+	return fmt.Sprintf(
+		"q=%s+timestamp:%d..%d&restrict_sr=on&sort=%s&limit=%d",
+		url.QueryEscape(fmt.Sprintf("%s flair:%q", query, flair)),
 		startTime,
 		endTime,
 		url.QueryEscape(sort),
 		limit,
 	)
+}
 
-	req, err := http.NewRequest("GET", searchURL, nil)
+// getJSON fetches a Reddit JSON endpoint into v, waiting for the rate limiter
+// first. Rate limiting and CAPTCHA pages are reported as ErrBlocked.
+func (f *PublicJSONFetcher) getJSON(reqURL string, v any) error {
+	f.rateLimiter.wait()
+
+	req, err := http.NewRequest("GET", reqURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
+		return fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("User-Agent", f.userAgent)
 
 	resp, err := f.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("fetch from reddit: %w", err)
+		return fmt.Errorf("fetch from reddit: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w: rate limit (status 429)", ErrBlocked)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("reddit API error: status %d, body: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("reddit API error: status %d, body: %s", resp.StatusCode, string(body))
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("read response: %w", err)
+		return fmt.Errorf("read response: %w", err)
 	}
-
-	var searchResp redditSearchResponse
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, fmt.Errorf("parse response: %w", err)
+	if strings.Contains(resp.Header.Get("Content-Type"), "text/html") ||
+		strings.HasPrefix(strings.TrimSpace(string(body)), "<") {
+		return fmt.Errorf("%w: got HTML instead of JSON (CAPTCHA page)", ErrBlocked)
 	}
 
-	results := make([]SearchResult, 0, len(searchResp.Data.Children))
-	for _, child := range searchResp.Data.Children {
-		result := child.Data.toSearchResult()
-		// Only include posts with Highlight flair (NBA highlight posts)
-		if result.Flair == "Highlight" {
-			results = append(results, result)
-		}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parse response: %w", err)
 	}
+	return nil
+}
 
-	return results, nil
+// isBlocked reports whether err means Reddit is blocking requests.
+func isBlocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, ErrBlocked) ||
+		strings.Contains(err.Error(), "CAPTCHA") ||
+		strings.Contains(err.Error(), "blocking requests") ||
+		strings.Contains(err.Error(), "rate limit") ||
+		strings.Contains(err.Error(), "HTML instead of JSON")
 }
 
-// Client provides game highlight link fetching from Reddit r/nba.
-// Uses Reddit's public JSON API for goal link retrieval.
+// Client provides play highlight link fetching from Reddit r/nba.
+// Uses Reddit's public JSON API for highlight link retrieval.
 type Client struct {
 	fetcher     Fetcher // Reddit public API fetcher
-	cache       *GoalLinkCache
+	cache       *HighlightCache
+	threads     *ThreadCache
 	debugLogger DebugLogger // Optional debug logger function
+
+	mu           sync.Mutex
+	blockedUntil time.Time // no requests until then, see BlockedBackoff
 }
 
 // debugLog is a helper method to safely call the debug logger if it exists
@@ -144,49 +190,88 @@ func (c *Client) debugLog(message string) {
 	}
 }
 
-// NewClient creates a new Reddit client with the default public JSON fetcher.
-func NewClient() (*Client, error) {
-	cache, err := NewGoalLinkCache()
+// checkBlocked returns ErrBlocked while the client is backing off.
+func (c *Client) checkBlocked() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Now().Before(c.blockedUntil) {
+		return fmt.Errorf("%w: backing off until %s", ErrBlocked, c.blockedUntil.Format("15:04:05"))
+	}
+	return nil
+}
+
+// noteBlocked starts backing off for BlockedBackoff if err means Reddit is
+// blocking requests.
+func (c *Client) noteBlocked(err error) {
+	if !isBlocked(err) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.blockedUntil = time.Now().Add(BlockedBackoff)
+}
+
+// NewClient creates a new Reddit client with the fetcher NewFetcher picks for
+// creds: Reddit's OAuth API when app credentials are set, and the public JSON
+// endpoints otherwise.
+func NewClient(creds data.RedditSettings) (*Client, error) {
+	cache, err := NewHighlightCache()
 	if err != nil {
 		return nil, fmt.Errorf("create cache: %w", err)
 	}
+	threads, err := NewThreadCache()
+	if err != nil {
+		return nil, fmt.Errorf("create thread cache: %w", err)
+	}
 
 	return &Client{
-		fetcher: NewPublicJSONFetcher(),
+		fetcher: NewFetcher(creds),
 		cache:   cache,
+		threads: threads,
 	}, nil
 }
 
 // NewClientWithDebug creates a new Reddit client with debug logging enabled.
-// Uses public JSON API like main branch.
-func NewClientWithDebug(debugLogger DebugLogger) (*Client, error) {
-	cache, err := NewGoalLinkCache()
+// Picks its fetcher like NewClient.
+func NewClientWithDebug(creds data.RedditSettings, debugLogger DebugLogger) (*Client, error) {
+	cache, err := NewHighlightCache()
 	if err != nil {
 		return nil, fmt.Errorf("create cache: %w", err)
 	}
+	threads, err := NewThreadCache()
+	if err != nil {
+		return nil, fmt.Errorf("create thread cache: %w", err)
+	}
 
-	debugLogger("Initializing Reddit client with public API")
+	fetcher := NewFetcher(creds)
+	if _, ok := fetcher.(*OAuthFetcher); ok {
+		debugLogger("Initializing Reddit client with OAuth app credentials")
+	} else {
+		debugLogger("Initializing Reddit client with public API")
+	}
 
 	return &Client{
-		fetcher:     NewPublicJSONFetcher(),
+		fetcher:     fetcher,
 		cache:       cache,
+		threads:     threads,
 		debugLogger: debugLogger,
 	}, nil
 }
 
 // NewClientWithFetcher creates a new Reddit client with a custom fetcher.
-// Use this for testing with custom fetchers.
-func NewClientWithFetcher(fetcher Fetcher, cache *GoalLinkCache) *Client {
+// Use this for testing with custom fetchers; game threads are cached in memory.
+func NewClientWithFetcher(fetcher Fetcher, cache *HighlightCache) *Client {
 	return &Client{
 		fetcher: fetcher,
 		cache:   cache,
+		threads: newMemoryThreadCache(),
 	}
 }
 
-// GoalLink retrieves a cached goal link or fetches from Reddit if not cached.
-// Returns nil if the goal link was previously searched but not found.
-func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
-	key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
+// Highlight retrieves a cached highlight link or fetches from Reddit if not cached.
+// Returns nil if the play was previously searched but not found.
+func (c *Client) Highlight(play PlayInfo) (*Highlight, error) {
+	key := play.Key()
 
 	// Check cache first (includes "not found" markers)
 	if link := c.cache.Get(key); link != nil {
@@ -197,8 +282,8 @@ func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
 		return link, nil
 	}
 
-	// Search Reddit for the goal
-	link, err := c.searchForGoal(goal)
+	// Search Reddit for the play
+	link, err := c.searchForPlay(play)
 	if err != nil {
 		// Don't cache errors - allow retry
 		return nil, err
@@ -209,30 +294,36 @@ func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
 		_ = c.cache.Set(*link)
 	} else {
 		// Cache "not found" to avoid re-searching
-		_ = c.cache.SetNotFound(goal.MatchID, goal.Minute)
+		_ = c.cache.SetNotFound(key)
 	}
 
 	return link, nil
 }
 
-// BatchSize is the maximum number of goals to fetch per batch.
+// BatchSize is the maximum number of plays to fetch per batch.
 // Reduced to make requests even more spaced out.
 const BatchSize = 3
 
 // BatchDelay is the delay between batches to avoid rate limiting.
 const BatchDelay = 5 * time.Second
 
-// GoalLinks retrieves links for multiple goals, using cache where available.
-// Goals are de-duplicated and batched to avoid rate limiting.
-func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
-	results := make(map[GoalLinkKey]*GoalLink)
+// MaxSearchesPerCall is the most plays one Highlights call searches for.
+// Each play takes up to two requests against the public API's limit of 10
+// a minute; the plays left over are searched on a later call.
+const MaxSearchesPerCall = 6
+
+// Highlights retrieves links for multiple plays, using cache where available.
+// Plays are de-duplicated and batched to avoid rate limiting, and searched in
+// the order given, so callers pass the newest first.
+func (c *Client) Highlights(plays []PlayInfo) map[PlayKey]*Highlight {
+	results := make(map[PlayKey]*Highlight)
 
-	// De-duplicate goals by key and filter out already-cached goals
-	seen := make(map[GoalLinkKey]bool)
-	var uncachedGoals []GoalInfo
+	// De-duplicate plays by key and filter out already-cached plays
+	seen := make(map[PlayKey]bool)
+	var uncachedPlays []PlayInfo
 
-	for _, goal := range goals {
-		key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
+	for _, play := range plays {
+		key := play.Key()
 
 		// Skip duplicates
 		if seen[key] {
@@ -249,11 +340,13 @@ func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
 			continue
 		}
 
-		uncachedGoals = append(uncachedGoals, goal)
+		if len(uncachedPlays) < MaxSearchesPerCall {
+			uncachedPlays = append(uncachedPlays, play)
+		}
 	}
 
-	// Fetch uncached goals in batches with conservative delays
-	for i := 0; i < len(uncachedGoals); i += BatchSize {
+	// Fetch uncached plays in batches with conservative delays
+	for i := 0; i < len(uncachedPlays); i += BatchSize {
 		// Add delay between batches (not before first batch)
 		if i > 0 {
 			time.Sleep(BatchDelay)
@@ -261,13 +354,12 @@ func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
 
 		// Process batch
 		end := i + BatchSize
-		end = min(end, len(uncachedGoals))
+		end = min(end, len(uncachedPlays))
 
-		for _, goal := range uncachedGoals[i:end] {
-			key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
-			link, err := c.GoalLink(goal)
+		for _, play := range uncachedPlays[i:end] {
+			link, err := c.Highlight(play)
 			if err == nil && link != nil {
-				results[key] = link
+				results[play.Key()] = link
 			}
 		}
 	}
@@ -275,12 +367,16 @@ func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
 	return results
 }
 
-// searchForGoal searches Reddit for a specific goal with conservative retry logic.
-func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
+// searchForPlay searches Reddit for a specific play with conservative retry logic.
+func (c *Client) searchForPlay(play PlayInfo) (*Highlight, error) {
 	// Conservative retry logic - Reddit is very aggressive with CAPTCHA detection
 	maxRetries := 2               // Reduced from 3
 	baseDelay := 60 * time.Second // Increased delay between retries
 
+	if err := c.checkBlocked(); err != nil {
+		return nil, err
+	}
+
 	var lastErr error
 	for attempt := range maxRetries {
 		if attempt > 0 {
@@ -289,7 +385,7 @@ func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
 			time.Sleep(delay)
 		}
 
-		result, err := c.searchForGoalOnce(goal)
+		result, err := c.searchForPlayOnce(play)
 		if err == nil {
 			return result, nil
 		}
@@ -297,12 +393,10 @@ func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
 		lastErr = err
 
 		// Check if this is a CAPTCHA/rate limit error
-		if strings.Contains(err.Error(), "CAPTCHA") ||
-			strings.Contains(err.Error(), "blocking requests") ||
-			strings.Contains(err.Error(), "rate limit") ||
-			strings.Contains(err.Error(), "HTML instead of JSON") {
+		if isBlocked(err) {
 			// Don't retry CAPTCHA errors - Reddit is very aggressive, just give up
-			c.debugLog(fmt.Sprintf("Reddit blocking goal %d:%d: giving up immediately", goal.MatchID, goal.Minute))
+			c.noteBlocked(err)
+			c.debugLog(fmt.Sprintf("Reddit blocking play %s: giving up immediately", play.Key()))
 			return nil, err
 		}
 
@@ -315,15 +409,28 @@ func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
 	return nil, nil // No match found after all retries
 }
 
-// searchForGoalOnce performs a single search attempt for a goal.
-func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
-	// Strategy 1: Both teams + minute (most specific, try first)
-	query1 := fmt.Sprintf("%s %s %d'", goal.HomeTeam, goal.AwayTeam, goal.Minute)
-	c.debugLog(fmt.Sprintf("Reddit search query: '%s' for goal %d:%d (%s vs %s)",
-		query1, goal.MatchID, goal.Minute, goal.HomeTeam, goal.AwayTeam))
-	results1, err := c.fetcher.Search(query1, 15, goal.MatchTime, "relevance")
-	if err != nil {
-		c.debugLog(fmt.Sprintf("Reddit search failed for query '%s': %v", query1, err))
+// searchForPlayOnce performs a single search attempt for a play.
+func (c *Client) searchForPlayOnce(play PlayInfo) (*Highlight, error) {
+	player := lastName(play.Player)
+	if player == "" {
+		return nil, nil
+	}
+
+	// Strategy 1: player + what they did (most specific, try first)
+	what := play.Kind
+	if play.Detail != "" {
+		what = play.Detail
+	}
+	query1 := fmt.Sprintf("%s %s", player, strings.ReplaceAll(what, "-", " "))
+	c.debugLog(fmt.Sprintf("Reddit search query: '%s' for play %s (%s vs %s)",
+		query1, play.Key(), play.HomeTeam, play.AwayTeam))
+	results1, err1 := c.fetcher.Search(query1, 15, play.MatchTime, "relevance")
+	if err1 != nil {
+		c.debugLog(fmt.Sprintf("Reddit search failed for query '%s': %v", query1, err1))
+		if isBlocked(err1) {
+			// Don't send Reddit another request right after it blocked us
+			return nil, err1
+		}
 	} else {
 		c.debugLog(fmt.Sprintf("Reddit search returned %d results for query '%s'", len(results1), query1))
 		// Debug: log the first few result titles
@@ -332,143 +439,70 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 				c.debugLog(fmt.Sprintf("Result %d: '%s'", i+1, result.Title))
 			}
 		}
-	}
-	if err == nil {
-		// Check if we found a good match with the first strategy
-		match := findBestMatch(results1, goal)
-		c.debugLog(fmt.Sprintf("findBestMatch result for goal %d:%d (score %d-%d): %v", goal.MatchID, goal.Minute, goal.HomeScore, goal.AwayScore, match != nil))
-		if match != nil {
-			c.debugLog(fmt.Sprintf("Found goal link for %d:%d: %s (post: %s)", goal.MatchID, goal.Minute, match.URL, match.PostURL))
+		if match := findBestMatch(results1, play); match != nil {
 			// Found a match, return it immediately to avoid additional API calls
-			return &GoalLink{
-				MatchID:   goal.MatchID,
-				Minute:    goal.Minute,
-				URL:       match.URL,
-				Title:     match.Title,
-				PostURL:   match.PostURL,
-				FetchedAt: time.Now(),
-			}, nil
+			return c.newHighlight(play, match), nil
 		}
 	}
 
-	// Strategy 1 didn't find a match, try broader searches
-	// Only try one additional strategy to balance coverage vs rate limiting
-	var allResults []SearchResult
-	if err == nil {
-		allResults = append(allResults, results1...)
-	}
-
-	// Strategy 2: Try with just the scoring team + minute
-	// Determine which team scored
-	scoringTeam := goal.AwayTeam
-	if goal.IsHomeTeam {
-		scoringTeam = goal.HomeTeam
+	// Strategy 2: player + their team, the most upvoted first; catches the
+	// titles that describe the play in words the keywords miss
+	team := play.AwayTeam
+	if play.IsHomeTeam {
+		team = play.HomeTeam
 	}
-	query2 := fmt.Sprintf("%s %d'", scoringTeam, goal.Minute)
-	c.debugLog(fmt.Sprintf("Reddit search query (strategy 2): '%s' for goal %d:%d", query2, goal.MatchID, goal.Minute))
-	results2, err := c.fetcher.Search(query2, 15, goal.MatchTime, "relevance")
-	if err != nil {
-		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 2 query '%s': %v", query2, err))
-	} else {
-		c.debugLog(fmt.Sprintf("Reddit search returned %d results for strategy 2 query '%s'", len(results2), query2))
-		allResults = append(allResults, results2...)
+	query2 := fmt.Sprintf("%s %s", player, team)
+	c.debugLog(fmt.Sprintf("Reddit search query (strategy 2): '%s' for play %s", query2, play.Key()))
+	results2, err2 := c.fetcher.Search(query2, 15, play.MatchTime, "top")
+	if err2 != nil {
+		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 2 query '%s': %v", query2, err2))
+		if err1 != nil {
+			return nil, err2
+		}
 	}
 
 	// Remove duplicates based on URL
 	seen := make(map[string]bool)
-	uniqueResults := make([]SearchResult, 0, len(allResults))
-	for _, result := range allResults {
+	var uniqueResults []SearchResult
+	for _, result := range append(results1, results2...) {
 		if !seen[result.URL] {
 			seen[result.URL] = true
 			uniqueResults = append(uniqueResults, result)
 		}
 	}
 
-	// Check if strategies 1+2 found a match before trying strategy 3
-	match := findBestMatch(uniqueResults, goal)
-	if match != nil {
-		c.debugLog(fmt.Sprintf("Strategy 1+2 match found for goal %d:%d, skipping strategy 3", goal.MatchID, goal.Minute))
-		c.debugLog(fmt.Sprintf("Found goal link for %d:%d: %s (post: %s)", goal.MatchID, goal.Minute, match.URL, match.PostURL))
-		return &GoalLink{
-			MatchID:   goal.MatchID,
-			Minute:    goal.Minute,
-			URL:       match.URL,
-			Title:     match.Title,
-			PostURL:   match.PostURL,
-			FetchedAt: time.Now(),
-		}, nil
-	}
-
-	// Strategy 3: Try with short/alternative team names + sort by top (upvotes)
-	// Only runs if strategies 1+2 did not find a match
-	homeShort := strings.TrimSpace(goal.HomeTeamShort)
-	awayShort := strings.TrimSpace(goal.AwayTeamShort)
-
-	// Skip if short names are empty or identical to full names (avoids redundant API call)
-	homeShortDifferent := homeShort != "" && !strings.EqualFold(homeShort, goal.HomeTeam)
-	awayShortDifferent := awayShort != "" && !strings.EqualFold(awayShort, goal.AwayTeam)
-
-	if !homeShortDifferent && !awayShortDifferent {
-		c.debugLog(fmt.Sprintf("Skipping strategy 3 for goal %d:%d: short names empty or identical to full names", goal.MatchID, goal.Minute))
-		return nil, nil // No match found across all strategies
-	}
-
-	// Build query using short names where they differ, falling back to full names
-	homeQuery := goal.HomeTeam
-	if homeShortDifferent {
-		homeQuery = homeShort
-	}
-	awayQuery := goal.AwayTeam
-	if awayShortDifferent {
-		awayQuery = awayShort
-	}
-
-	query3 := fmt.Sprintf("%s %s %d'", homeQuery, awayQuery, goal.Minute)
-	c.debugLog(fmt.Sprintf("Reddit search query (strategy 3): '%s' for goal %d:%d", query3, goal.MatchID, goal.Minute))
-	results3, err := c.fetcher.Search(query3, 15, goal.MatchTime, "top")
-	if err != nil {
-		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 3 query '%s': %v", query3, err))
-	} else {
-		c.debugLog(fmt.Sprintf("Reddit search returned %d results for strategy 3 query '%s'", len(results3), query3))
-		// Debug: log the first few result titles
-		for i, result := range results3 {
-			if i < 3 { // Log first 3 results
-				c.debugLog(fmt.Sprintf("Strategy 3 result %d: '%s' (score: %d)", i+1, result.Title, result.Score))
-			}
-		}
-		// Combine with all prior results for best match selection
-		for _, result := range results3 {
-			if !seen[result.URL] {
-				seen[result.URL] = true
-				uniqueResults = append(uniqueResults, result)
-			}
-		}
-	}
-
-	// Find the best matching result across all strategies
-	match = findBestMatch(uniqueResults, goal)
-	c.debugLog(fmt.Sprintf("findBestMatch result (strategy 3) for goal %d:%d: %v", goal.MatchID, goal.Minute, match != nil))
+	match := findBestMatch(uniqueResults, play)
+	c.debugLog(fmt.Sprintf("findBestMatch result (strategy 2) for play %s: %v", play.Key(), match != nil))
 	if match == nil {
 		return nil, nil // No match found, but not an error
 	}
+	return c.newHighlight(play, match), nil
+}
 
-	c.debugLog(fmt.Sprintf("Found goal link (strategy 3) for %d:%d: %s (post: %s)", goal.MatchID, goal.Minute, match.URL, match.PostURL))
-	return &GoalLink{
-		MatchID:   goal.MatchID,
-		Minute:    goal.Minute,
+// newHighlight creates the highlight of play from a matching search result.
+func (c *Client) newHighlight(play PlayInfo, match *SearchResult) *Highlight {
+	c.debugLog(fmt.Sprintf("Found highlight for %s: %s (post: %s)", play.Key(), match.URL, match.PostURL))
+	return &Highlight{
+		MatchID:   play.MatchID,
+		EventID:   play.EventID,
+		Period:    play.Period,
+		Clock:     play.Clock,
 		URL:       match.URL,
 		Title:     match.Title,
 		PostURL:   match.PostURL,
 		FetchedAt: time.Now(),
-	}, nil
+
+		Confidence: CalculateConfidence(*match, play),
+		Upvotes:    match.Score,
+	}
 }
 
-// ClearCache clears the goal link cache.
+// ClearCache clears the highlight link cache.
 func (c *Client) ClearCache() error {
 	return c.cache.Clear()
 }
 
 // Cache returns the underlying cache for direct access if needed.
-func (c *Client) Cache() *GoalLinkCache {
+func (c *Client) Cache() *HighlightCache {
 	return c.cache
 }
@@ -0,0 +1,115 @@
+package reddit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Comment is a top-level comment of a Reddit thread.
+type Comment struct {
+	ID        string
+	Author    string
+	Body      string
+	Score     int
+	CreatedAt time.Time
+	Stickied  bool
+}
+
+// redditCommentsResponse is the JSON of a thread: the post listing, then the
+// comments listing.
+type redditCommentsResponse []struct {
+	Data struct {
+		Children []struct {
+			Kind string        `json:"kind"` // "t1" for comments, "more" for the rest
+			Data redditComment `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// redditComment represents a single comment from Reddit's API.
+type redditComment struct {
+	ID         string  `json:"id"`
+	Author     string  `json:"author"`
+	Body       string  `json:"body"`
+	Score      int     `json:"score"`
+	CreatedUTC float64 `json:"created_utc"`
+	Stickied   bool    `json:"stickied"`
+}
+
+// Comments returns the newest top-level comments of the thread at postURL,
+// a Reddit post URL as in SearchResult.PostURL.
+func (f *PublicJSONFetcher) Comments(postURL string, limit int) ([]Comment, error) {
+	commentsURL := strings.TrimSuffix(postURL, "/") + ".json?" + commentsQuery(limit)
+
+	var resp redditCommentsResponse
+	if err := f.getJSON(commentsURL, &resp); err != nil {
+		return nil, err
+	}
+	return resp.comments()
+}
+
+// commentsQuery returns the query string asking for the newest top-level
+// comments of a thread.
+func commentsQuery(limit int) string {
+	// raw_json keeps "&" and "<" as they were typed instead of HTML entities
+	return fmt.Sprintf("sort=new&limit=%d&depth=1&raw_json=1", limit)
+}
+
+// comments returns the top-level comments of a thread's JSON.
+func (r redditCommentsResponse) comments() ([]Comment, error) {
+	if len(r) < 2 {
+		return nil, fmt.Errorf("parse response: no comments listing")
+	}
+
+	comments := make([]Comment, 0, len(r[1].Data.Children))
+	for _, child := range r[1].Data.Children {
+		if child.Kind != "t1" {
+			continue
+		}
+		c := child.Data
+		comments = append(comments, Comment{
+			ID:        c.ID,
+			Author:    c.Author,
+			Body:      c.Body,
+			Score:     c.Score,
+			CreatedAt: time.Unix(int64(c.CreatedUTC), 0),
+			Stickied:  c.Stickied,
+		})
+	}
+	return comments, nil
+}
+
+// Comments returns up to limit of the newest top-level comments of a thread,
+// newest first. Stickied, bot and deleted comments are left out. Nothing is
+// requested while Reddit is blocking requests.
+func (c *Client) Comments(postURL string, limit int) ([]Comment, error) {
+	if err := c.checkBlocked(); err != nil {
+		return nil, err
+	}
+
+	// Ask for extra to make up for the comments left out
+	fetched, err := c.fetcher.Comments(postURL, limit*2)
+	if err != nil {
+		c.debugLog(fmt.Sprintf("Reddit comments failed for %s: %v", postURL, err))
+		c.noteBlocked(err)
+		return nil, err
+	}
+
+	comments := make([]Comment, 0, len(fetched))
+	for _, comment := range fetched {
+		if comment.Stickied || comment.Author == "AutoModerator" ||
+			comment.Body == "[deleted]" || comment.Body == "[removed]" {
+			continue
+		}
+		comments = append(comments, comment)
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.After(comments[j].CreatedAt)
+	})
+	if len(comments) > limit {
+		comments = comments[:limit]
+	}
+	return comments, nil
+}
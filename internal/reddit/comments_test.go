@@ -0,0 +1,131 @@
+package reddit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stubFetcher serves canned comments and counts the requests made. Searches
+// fail with searchErr.
+type stubFetcher struct {
+	comments  []Comment
+	err       error
+	calls     int
+	searchErr error
+	searches  int
+}
+
+func (f *stubFetcher) Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	f.searches++
+	return nil, f.searchErr
+}
+
+func (f *stubFetcher) SearchFlair(flair, query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	return nil, nil
+}
+
+func (f *stubFetcher) Comments(postURL string, limit int) ([]Comment, error) {
+	f.calls++
+	return f.comments, f.err
+}
+
+func TestClientComments(t *testing.T) {
+	now := time.Now()
+	f := &stubFetcher{comments: []Comment{
+		{ID: "a", Author: "fan1", Body: "first", CreatedAt: now.Add(-3 * time.Minute)},
+		{ID: "b", Author: "AutoModerator", Body: "rules", CreatedAt: now},
+		{ID: "c", Author: "fan2", Body: "third", CreatedAt: now.Add(-time.Minute)},
+		{ID: "d", Author: "mods", Body: "sticky", CreatedAt: now, Stickied: true},
+		{ID: "e", Author: "fan3", Body: "[deleted]", CreatedAt: now},
+		{ID: "f", Author: "fan4", Body: "second", CreatedAt: now.Add(-2 * time.Minute)},
+	}}
+	c := NewClientWithFetcher(f, nil)
+
+	comments, err := c.Comments("https://www.reddit.com/r/nba/comments/x/game_thread/", 2)
+	if err != nil {
+		t.Fatalf("Comments: %v", err)
+	}
+	var ids []string
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+	if got := strings.Join(ids, ","); got != "c,f" {
+		t.Errorf("comments = %s; want the two newest from fans, c,f", got)
+	}
+}
+
+func TestClientCommentsBacksOffWhenBlocked(t *testing.T) {
+	f := &stubFetcher{err: fmt.Errorf("%w: got HTML instead of JSON (CAPTCHA page)", ErrBlocked)}
+	c := NewClientWithFetcher(f, nil)
+
+	for range 3 {
+		if _, err := c.Comments("https://www.reddit.com/r/nba/comments/x/", 10); !errors.Is(err, ErrBlocked) {
+			t.Fatalf("Comments error = %v; want ErrBlocked", err)
+		}
+	}
+	if f.calls != 1 {
+		t.Errorf("fetcher called %d times; want 1, then backing off", f.calls)
+	}
+}
+
+func TestPublicJSONFetcherComments(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/r/nba/comments/x.json" || r.URL.Query().Get("sort") != "new" {
+			t.Errorf("request = %s; want the thread JSON sorted by new", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"data":{"children":[]}},{"data":{"children":[
+			{"kind":"t1","data":{"id":"a","author":"fan","body":"and-one & the foul","score":12,"created_utc":1700000000}},
+			{"kind":"more","data":{"id":"b"}}]}}]`)
+	}))
+	defer srv.Close()
+
+	comments, err := NewPublicJSONFetcher().Comments(srv.URL+"/r/nba/comments/x/", 10)
+	if err != nil {
+		t.Fatalf("Comments: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Body != "and-one & the foul" || comments[0].Score != 12 {
+		t.Errorf("comments = %+v; want the one comment", comments)
+	}
+}
+
+func TestPublicJSONFetcherCAPTCHA(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<html>are you a robot?</html>")
+	}))
+	defer srv.Close()
+
+	if _, err := NewPublicJSONFetcher().Comments(srv.URL+"/r/nba/comments/x/", 10); !errors.Is(err, ErrBlocked) {
+		t.Errorf("Comments error = %v; want ErrBlocked", err)
+	}
+}
+
+func TestSearchForPlayStopsWhenBlocked(t *testing.T) {
+	play := PlayInfo{MatchID: 1, EventID: 612, Period: 4, Clock: "0:04", HomeTeam: "Boston Celtics", AwayTeam: "Miami Heat", Player: "J. Tatum", Kind: "dunk"}
+	tests := []struct {
+		name         string
+		err          error
+		wantSearches int
+	}{
+		{"blocked", fmt.Errorf("%w: got HTML instead of JSON (CAPTCHA page)", ErrBlocked), 1},
+		{"other failure", errors.New("connection reset"), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &stubFetcher{searchErr: tt.err}
+			c := NewClientWithFetcher(f, nil)
+			if _, err := c.searchForPlayOnce(play); !errors.Is(err, tt.err) {
+				t.Errorf("searchForPlayOnce error = %v; want %v", err, tt.err)
+			}
+			if f.searches != tt.wantSearches {
+				t.Errorf("made %d searches; want %d", f.searches, tt.wantSearches)
+			}
+		})
+	}
+}
@@ -1,33 +1,41 @@
 package reddit
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 	"time"
 )
 
-// NBA r/nba highlight post titles come in many formats, e.g.:
-//   - "Jayson Tatum 31 PTS - Full Game Highlights | Celtics vs Heat"
-//   - "LeBron James [32/8/7] - Game Highlights | Lakers vs Warriors"
-//   - "Boston Celtics vs Miami Heat - Full Game Highlights | Feb 25, 2026"
-//   - "Celtics [87] - Heat [79] 3rd Quarter Highlights"
+// r/nba highlight posts are one play each, titled "[Highlight]" and then a
+// description of the play in free form, e.g.:
+//   - "[Highlight] Jayson Tatum hits the game-winner to beat the Heat"
+//   - "[Highlight] Ja Morant throws down the poster on Wembanyama"
+//   - "[Highlight] Bam Adebayo with the huge block to seal it"
 //
-// Unlike soccer, NBA highlights don't encode a "minute" — they encode quarter
-// and total points. The matcher therefore focuses on:
-//   1. Team names in the title  (primary signal)
-//   2. Scorer name              (high value for scoring-event highlights)
-//   3. Score proximity          (exact score match is a good tiebreaker)
-//   4. Date proximity           (post must be within ±24h of the game)
-
-// findBestMatch finds the best matching Reddit search result for a basketball event.
-func findBestMatch(results []SearchResult, goal GoalInfo) *SearchResult {
-	if len(results) == 0 {
+// The titles carry no game clock, so the matcher looks for:
+//   1. Player name          (required)
+//   2. Play description     (words for the kind of play: "dunk", "block"...)
+//   3. Team names           (often in the title as the opponent)
+//   4. Date proximity       (post must be within -24h/+48h of the game)
+
+// playKeywords are the title words describing each kind of play.
+var playKeywords = map[string][]string{
+	"game-winner": {"game-winner", "game winner", "go-ahead", "go ahead", "buzzer", "clutch", "to win", "for the win", "seal"},
+	"milestone":   {"career-high", "career high", "double-double", "triple-double", "points", "threes"},
+	"three":       {"three", "3-pointer", "3pt", "triple", "from deep", "from way downtown", "logo", "tie"},
+	"dunk":        {"dunk", "slam", "jam", "poster", "throws down", "flush", "oop", "hammer"},
+	"block":       {"block", "swat", "reject", "denie", "stuff"},
+}
+
+// findBestMatch finds the best matching Reddit search result for a play.
+func findBestMatch(results []SearchResult, play PlayInfo) *SearchResult {
+	if len(results) == 0 || play.Player == "" {
 		return nil
 	}
 
-	homeNorm := normalizeTeamName(goal.HomeTeam)
-	awayNorm := normalizeTeamName(goal.AwayTeam)
+	playerNorm := normalizeName(play.Player)
+	homeNorm := normalizeTeamName(play.HomeTeam)
+	awayNorm := normalizeTeamName(play.AwayTeam)
 
 	var bestMatch *SearchResult
 	bestScore := 0
@@ -39,62 +47,40 @@ func findBestMatch(results []SearchResult, goal GoalInfo) *SearchResult {
 		score := 0
 
 		// ── 1. Date filter ─────────────────────────────────────────────
-		if !goal.MatchTime.IsZero() {
+		if !play.MatchTime.IsZero() {
 			postDate := result.CreatedAt
-			matchStart := goal.MatchTime.Add(-24 * time.Hour)
-			matchEnd := goal.MatchTime.Add(48 * time.Hour)
+			matchStart := play.MatchTime.Add(-24 * time.Hour)
+			matchEnd := play.MatchTime.Add(48 * time.Hour)
 
 			if postDate.Before(matchStart) || postDate.After(matchEnd) {
 				continue // outside valid window
 			}
-			// Bonus for posts very close to game time
-			if postDate.After(goal.MatchTime.Add(-6*time.Hour)) && postDate.Before(goal.MatchTime.Add(12*time.Hour)) {
-				score += 5
-			}
 		}
 
-		// ── 2. Team names ──────────────────────────────────────────────
-		homeFound := containsTeamName(titleLower, homeNorm)
-		awayFound := containsTeamName(titleLower, awayNorm)
-
-		if !homeFound && !awayFound {
-			continue // at least one team required
-		}
-		if homeFound {
-			score += 10
-		}
-		if awayFound {
-			score += 10
-		}
-		if homeFound && awayFound {
-			score += 5 // bonus for both teams present
+		// ── 2. Player name ─────────────────────────────────────────────
+		// Every clip is of someone; a title without the player is another play
+		if !containsName(titleLower, playerNorm) {
+			continue
 		}
+		score += 20
 
-		// ── 3. Scorer / player name ────────────────────────────────────
-		// For NBA, the scorer name (e.g., "Tatum", "LeBron") is often the
-		// most prominent signal in the title.
-		if goal.ScorerName != "" {
-			scorerNorm := normalizeName(goal.ScorerName)
-			if containsName(titleLower, scorerNorm) {
-				score += 20 // High bonus — player name in title is very specific
-			}
+		// ── 3. Play description ────────────────────────────────────────
+		if describesPlay(titleLower, play) {
+			score += 15
 		}
 
-		// ── 4. Score proximity ─────────────────────────────────────────
-		// NBA highlight titles sometimes embed the final score like
-		// "Celtics [87] - Heat [79]" or "BOS 112, PHI 104".
-		// We check for both the home and away score as integers.
-		if scoreFoundInTitle(titleLower, goal.HomeScore, goal.AwayScore) {
-			score += 15
+		// ── 4. Team names ──────────────────────────────────────────────
+		if containsTeamName(titleLower, homeNorm) {
+			score += 5
+		}
+		if containsTeamName(titleLower, awayNorm) {
+			score += 5
 		}
 
 		// ── 5. Keyword bonuses ─────────────────────────────────────────
-		if strings.Contains(titleLower, "highlight") {
+		if strings.HasPrefix(titleLower, "[highlight]") {
 			score += 3
 		}
-		if strings.Contains(titleLower, "game winner") || strings.Contains(titleLower, "buzzer") {
-			score += 5
-		}
 
 		// ── 6. Upvote tiebreaker ───────────────────────────────────────
 		score += min(result.Score/200, 5) // max 5 pts from upvotes
@@ -105,26 +91,36 @@ func findBestMatch(results []SearchResult, goal GoalInfo) *SearchResult {
 		}
 	}
 
-	// NBA highlight matching is softer than soccer goals — we only need
-	// at least one team name plus either a player or score match.
-	const minScore = 20
+	// The player and either the play or both teams: a title with only the
+	// player's name is as likely to be any other play of theirs
+	const minScore = 30
 	if bestScore < minScore {
 		return nil
 	}
 	return bestMatch
 }
 
-// scoreFoundInTitle checks if both the home and away score appear in the title
-// as standalone integers (e.g., "87" and "79" in "Celtics [87] - Heat [79]").
-func scoreFoundInTitle(title string, home, away int) bool {
-	if home <= 0 && away <= 0 {
-		return false
+// describesPlay reports whether a title has words for the kind of play, or
+// the milestone reached.
+func describesPlay(title string, play PlayInfo) bool {
+	if play.Detail != "" && strings.Contains(title, strings.ToLower(play.Detail)) {
+		return true
+	}
+	for _, word := range playKeywords[play.Kind] {
+		if strings.Contains(title, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// lastName returns the last word of a player's name, "Tatum" for "J. Tatum".
+func lastName(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) == 0 {
+		return ""
 	}
-	homePattern := fmt.Sprintf(`\b%d\b`, home)
-	awayPattern := fmt.Sprintf(`\b%d\b`, away)
-	homeRe := regexp.MustCompile(homePattern)
-	awayRe := regexp.MustCompile(awayPattern)
-	return homeRe.MatchString(title) && awayRe.MatchString(title)
+	return parts[len(parts)-1]
 }
 
 // normalizeTeamName converts an NBA team name to a normalized form for matching.
@@ -152,6 +148,9 @@ func normalizeName(name string) string {
 
 // containsTeamName checks if a title contains a team name or any significant word from it.
 func containsTeamName(title, teamNorm string) bool {
+	if teamNorm == "" {
+		return false
+	}
 	titleNorm := normalizeTeamName(title)
 
 	if strings.Contains(titleNorm, teamNorm) {
@@ -196,28 +195,21 @@ const (
 )
 
 // CalculateConfidence returns the confidence level for a Reddit result match.
-func CalculateConfidence(result SearchResult, goal GoalInfo) MatchConfidence {
+func CalculateConfidence(result SearchResult, play PlayInfo) MatchConfidence {
 	titleLower := strings.ToLower(result.Title)
-	homeNorm := normalizeTeamName(goal.HomeTeam)
-	awayNorm := normalizeTeamName(goal.AwayTeam)
-
-	hasHome := containsTeamName(titleLower, homeNorm)
-	hasAway := containsTeamName(titleLower, awayNorm)
-
-	hasPlayer := false
-	if goal.ScorerName != "" {
-		hasPlayer = containsName(titleLower, normalizeName(goal.ScorerName))
-	}
 
-	hasScore := scoreFoundInTitle(titleLower, goal.HomeScore, goal.AwayScore)
+	hasPlayer := play.Player != "" && containsName(titleLower, normalizeName(play.Player))
+	hasPlay := describesPlay(titleLower, play)
+	hasTeam := containsTeamName(titleLower, normalizeTeamName(play.HomeTeam)) ||
+		containsTeamName(titleLower, normalizeTeamName(play.AwayTeam))
 
-	if hasHome && hasAway && (hasPlayer || hasScore) {
+	if hasPlayer && hasPlay && hasTeam {
 		return ConfidenceHigh
 	}
-	if (hasHome || hasAway) && (hasPlayer || hasScore) {
+	if hasPlayer && (hasPlay || hasTeam) {
 		return ConfidenceMedium
 	}
-	if hasHome || hasAway {
+	if hasPlayer {
 		return ConfidenceLow
 	}
 	return ConfidenceNone
@@ -0,0 +1,359 @@
+package reddit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// Reddit's OAuth endpoints.
+const (
+	oauthTokenURL = "https://www.reddit.com/api/v1/access_token"
+	oauthAPIURL   = "https://oauth.reddit.com"
+)
+
+// tokenFileName caches the access token between runs, in the cache directory.
+const tokenFileName = "reddit_token.json"
+
+// tokenRefreshMargin is how long before it expires a token is replaced.
+const tokenRefreshMargin = time.Minute
+
+// maxRateLimitWait is the longest a request waits for Reddit's rate limit
+// window to reset; longer waits fail with ErrBlocked instead.
+const maxRateLimitWait = 10 * time.Second
+
+// errCredentialsRejected is returned when Reddit refuses the app credentials.
+var errCredentialsRejected = errors.New("reddit rejected the app credentials")
+
+// NewFetcher returns an OAuthFetcher when creds has a client ID, and the
+// PublicJSONFetcher, sending creds.UserAgent if set, otherwise. creds are the
+// Reddit settings in effect, environment overrides included
+// (data.Settings.Effective).
+func NewFetcher(creds data.RedditSettings) Fetcher {
+	if creds.ClientID == "" {
+		f := NewPublicJSONFetcher()
+		if creds.UserAgent != "" {
+			f.userAgent = creds.UserAgent
+		}
+		return f
+	}
+	return NewOAuthFetcher(creds)
+}
+
+// OAuthFetcher uses Reddit's OAuth API with the credentials of a Reddit app.
+// It keeps to the rate limit Reddit reports in its response headers, and
+// falls back to the public JSON endpoints if Reddit rejects the credentials.
+type OAuthFetcher struct {
+	creds       data.RedditSettings
+	userAgent   string
+	httpClient  *http.Client
+	rateLimiter *rateLimiter
+	fallback    *PublicJSONFetcher
+	tokenURL    string
+	apiURL      string
+	tokenPath   string // empty keeps the token in memory
+
+	mu        sync.Mutex
+	token     oauthToken
+	remaining float64   // requests left in the rate limit window; -1 if unknown
+	resetAt   time.Time // when the rate limit window resets
+	rejected  bool      // the credentials were rejected: use the fallback
+}
+
+// oauthToken is an access token and what it was issued for.
+type oauthToken struct {
+	ClientID    string    `json:"client_id"`
+	Username    string    `json:"username,omitempty"`
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// NewOAuthFetcher creates a fetcher signing in with creds. The access token
+// is cached on disk and replaced shortly before it expires.
+func NewOAuthFetcher(creds data.RedditSettings) *OAuthFetcher {
+	f := newOAuthFetcher(creds, oauthTokenURL, oauthAPIURL)
+	if dir, err := data.CacheDir(); err == nil {
+		f.tokenPath = filepath.Join(dir, tokenFileName)
+		f.loadToken()
+	}
+	return f
+}
+
+// newOAuthFetcher creates a fetcher against the given endpoints that keeps
+// its token in memory.
+func newOAuthFetcher(creds data.RedditSettings, tokenURL, apiURL string) *OAuthFetcher {
+	userAgent := creds.UserAgent
+	if userAgent == "" {
+		owner := creds.Username
+		if owner == "" {
+			owner = "courtside_app"
+		}
+		// Reddit asks for "<platform>:<app ID>:<version> (by /u/<username>)"
+		userAgent = fmt.Sprintf("courtside:%s:v1 (by /u/%s)", creds.ClientID, owner)
+	}
+	return &OAuthFetcher{
+		creds:      creds,
+		userAgent:  userAgent,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		// Reddit allows 100 requests a minute with OAuth; stay well below
+		rateLimiter: newRateLimiter(60),
+		fallback:    NewPublicJSONFetcher(),
+		tokenURL:    tokenURL,
+		apiURL:      apiURL,
+		remaining:   -1,
+	}
+}
+
+// Search performs a search on r/nba for Highlight posts, like
+// PublicJSONFetcher.Search.
+func (f *OAuthFetcher) Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	return f.SearchFlair("Highlight", query, limit, matchTime, sort)
+}
+
+// SearchFlair performs a search on r/nba for posts with the given flair, like
+// PublicJSONFetcher.SearchFlair.
+func (f *OAuthFetcher) SearchFlair(flair, query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	if f.isRejected() {
+		return f.fallback.SearchFlair(flair, query, limit, matchTime, sort)
+	}
+
+	var searchResp redditSearchResponse
+	err := f.getJSON(f.apiURL+"/r/nba/search?"+searchQuery(flair, query, limit, matchTime, sort), &searchResp)
+	if errors.Is(err, errCredentialsRejected) {
+		return f.fallback.SearchFlair(flair, query, limit, matchTime, sort)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return searchResp.withFlair(flair), nil
+}
+
+// Comments returns the newest top-level comments of a thread, like
+// PublicJSONFetcher.Comments.
+func (f *OAuthFetcher) Comments(postURL string, limit int) ([]Comment, error) {
+	if f.isRejected() {
+		return f.fallback.Comments(postURL, limit)
+	}
+
+	u, err := url.Parse(postURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse post url: %w", err)
+	}
+	var resp redditCommentsResponse
+	err = f.getJSON(f.apiURL+strings.TrimSuffix(u.Path, "/")+"?"+commentsQuery(limit), &resp)
+	if errors.Is(err, errCredentialsRejected) {
+		return f.fallback.Comments(postURL, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.comments()
+}
+
+// getJSON fetches an OAuth API endpoint into v. An access token refused by
+// the API is replaced and the request tried once more.
+func (f *OAuthFetcher) getJSON(reqURL string, v any) error {
+	for attempt := range 2 {
+		token, err := f.accessToken()
+		if err != nil {
+			return err
+		}
+		if err := f.waitForRateLimit(); err != nil {
+			return err
+		}
+		f.rateLimiter.wait()
+
+		req, err := http.NewRequest("GET", reqURL, nil)
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("User-Agent", f.userAgent)
+		req.Header.Set("Authorization", "bearer "+token)
+
+		resp, err := f.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("fetch from reddit: %w", err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		f.updateRateLimit(resp.Header)
+
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized && attempt == 0:
+			f.dropToken()
+			continue
+		case resp.StatusCode == http.StatusTooManyRequests:
+			return fmt.Errorf("%w: rate limit (status 429)", ErrBlocked)
+		case resp.StatusCode != http.StatusOK:
+			return fmt.Errorf("reddit API error: status %d, body: %s", resp.StatusCode, string(body))
+		case err != nil:
+			return fmt.Errorf("read response: %w", err)
+		}
+
+		if err := json.Unmarshal(body, v); err != nil {
+			return fmt.Errorf("parse response: %w", err)
+		}
+		return nil
+	}
+	return errors.New("reddit API error: access token refused")
+}
+
+// accessToken returns a valid access token, requesting a new one when the
+// current one is missing, expiring or for other credentials.
+func (f *OAuthFetcher) accessToken() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := f.token
+	if t.AccessToken != "" && t.ClientID == f.creds.ClientID && t.Username == f.creds.Username &&
+		time.Until(t.ExpiresAt) > tokenRefreshMargin {
+		return t.AccessToken, nil
+	}
+
+	t, err := f.requestToken()
+	if err != nil {
+		if errors.Is(err, errCredentialsRejected) {
+			f.rejected = true
+		}
+		return "", err
+	}
+	f.token = t
+	f.saveToken()
+	return t.AccessToken, nil
+}
+
+// requestToken requests an access token: with the owner's password for a
+// script app, with the app's secret alone, or as an installed app.
+func (f *OAuthFetcher) requestToken() (oauthToken, error) {
+	form := url.Values{}
+	switch {
+	case f.creds.Username != "" && f.creds.Password != "":
+		form.Set("grant_type", "password")
+		form.Set("username", f.creds.Username)
+		form.Set("password", f.creds.Password)
+	case f.creds.ClientSecret != "":
+		form.Set("grant_type", "client_credentials")
+	default:
+		form.Set("grant_type", "https://oauth.reddit.com/grants/installed_client")
+		form.Set("device_id", "DO_NOT_TRACK_THIS_DEVICE")
+	}
+
+	req, err := http.NewRequest("POST", f.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauthToken{}, fmt.Errorf("create token request: %w", err)
+	}
+	req.SetBasicAuth(f.creds.ClientID, f.creds.ClientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", f.userAgent)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return oauthToken{}, fmt.Errorf("request token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return oauthToken{}, fmt.Errorf("%w: status %d", errCredentialsRejected, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return oauthToken{}, fmt.Errorf("request token: status %d", resp.StatusCode)
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+		Error       string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return oauthToken{}, fmt.Errorf("parse token: %w", err)
+	}
+	// Wrong passwords come back as 200 with an error
+	if tokenResp.Error != "" || tokenResp.AccessToken == "" {
+		return oauthToken{}, fmt.Errorf("%w: %s", errCredentialsRejected, tokenResp.Error)
+	}
+
+	return oauthToken{
+		ClientID:    f.creds.ClientID,
+		Username:    f.creds.Username,
+		AccessToken: tokenResp.AccessToken,
+		ExpiresAt:   time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// dropToken forgets the access token, so that the next request gets a new one.
+func (f *OAuthFetcher) dropToken() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.token = oauthToken{}
+}
+
+// isRejected reports whether Reddit rejected the credentials.
+func (f *OAuthFetcher) isRejected() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rejected
+}
+
+// updateRateLimit records the rate limit state in Reddit's response headers.
+func (f *OAuthFetcher) updateRateLimit(h http.Header) {
+	remaining, err1 := strconv.ParseFloat(h.Get("X-Ratelimit-Remaining"), 64)
+	reset, err2 := strconv.Atoi(h.Get("X-Ratelimit-Reset"))
+	if err1 != nil || err2 != nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.remaining = remaining
+	f.resetAt = time.Now().Add(time.Duration(reset) * time.Second)
+}
+
+// waitForRateLimit waits for the rate limit window to reset when no requests
+// are left in it, or returns ErrBlocked if that takes longer than
+// maxRateLimitWait.
+func (f *OAuthFetcher) waitForRateLimit() error {
+	f.mu.Lock()
+	wait := time.Duration(0)
+	if f.remaining >= 0 && f.remaining < 1 {
+		wait = time.Until(f.resetAt)
+	}
+	f.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if wait > maxRateLimitWait {
+		return fmt.Errorf("%w: rate limit, resets in %s", ErrBlocked, wait.Round(time.Second))
+	}
+	time.Sleep(wait)
+	return nil
+}
+
+// loadToken reads the cached access token from disk.
+func (f *OAuthFetcher) loadToken() {
+	b, err := os.ReadFile(f.tokenPath)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(b, &f.token)
+}
+
+// saveToken writes the access token to disk, readable by the user only.
+func (f *OAuthFetcher) saveToken() {
+	if f.tokenPath == "" {
+		return
+	}
+	if b, err := json.Marshal(f.token); err == nil {
+		_ = os.WriteFile(f.tokenPath, b, 0600)
+	}
+}
@@ -0,0 +1,100 @@
+package reddit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+func TestOAuthFetcherRefreshesToken(t *testing.T) {
+	tokens, searches := 0, 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			if user, _, _ := r.BasicAuth(); user != "app" || r.FormValue("grant_type") != "password" {
+				t.Errorf("token request for %q with grant %q; want the script app's password grant", user, r.FormValue("grant_type"))
+			}
+			tokens++
+			fmt.Fprintf(w, `{"access_token":"t%d","expires_in":3600}`, tokens)
+		case "/r/nba/search":
+			searches++
+			// The first token is revoked; the second is good for one request
+			if r.Header.Get("Authorization") != "bearer t2" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("X-Ratelimit-Remaining", "0")
+			w.Header().Set("X-Ratelimit-Reset", "600")
+			fmt.Fprint(w, `{"data":{"children":[{"data":{"title":"[Highlight] Tatum","link_flair_text":"Highlight"}}]}}`)
+		}
+	}))
+	defer srv.Close()
+
+	creds := data.RedditSettings{ClientID: "app", ClientSecret: "secret", Username: "fan", Password: "pw"}
+	f := newOAuthFetcher(creds, srv.URL+"/token", srv.URL)
+
+	results, err := f.Search("Tatum", 10, time.Now(), "new")
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 1 || tokens != 2 {
+		t.Errorf("got %d results with %d tokens; want 1 result after replacing the refused token", len(results), tokens)
+	}
+
+	if _, err := f.Search("Tatum", 10, time.Now(), "new"); !errors.Is(err, ErrBlocked) {
+		t.Errorf("Search with no requests left = %v; want ErrBlocked", err)
+	}
+	if searches != 2 {
+		t.Errorf("%d searches reached Reddit; want 2, none once the rate limit is used up", searches)
+	}
+}
+
+func TestOAuthFetcherFallsBackWhenRejected(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/token":
+			fmt.Fprint(w, `{"error":"invalid_grant"}`)
+		case "/r/nba/comments/x.json":
+			fmt.Fprint(w, `[{"data":{"children":[]}},{"data":{"children":[{"kind":"t1","data":{"id":"a","body":"hi"}}]}}]`)
+		}
+	}))
+	defer srv.Close()
+
+	f := newOAuthFetcher(data.RedditSettings{ClientID: "app", Username: "fan", Password: "wrong"}, srv.URL+"/token", srv.URL)
+	comments, err := f.Comments(srv.URL+"/r/nba/comments/x/", 10)
+	if err != nil {
+		t.Fatalf("Comments: %v", err)
+	}
+	if len(comments) != 1 || !f.isRejected() {
+		t.Errorf("got %d comments, rejected %v; want the public endpoint's comment", len(comments), f.isRejected())
+	}
+	if fmt.Sprint(paths) != "[/token /r/nba/comments/x.json]" {
+		t.Errorf("requests = %v; want the token request, then the public endpoint", paths)
+	}
+}
+
+func TestNewFetcherUsesEffectiveSettings(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("COURTSIDE_REDDIT_USER_AGENT", "courtside:test:v1 (by /u/fan)")
+	settings, err := (&data.Settings{}).Effective()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, ok := NewFetcher(settings.Reddit).(*PublicJSONFetcher)
+	if !ok || f.userAgent != "courtside:test:v1 (by /u/fan)" {
+		t.Errorf("NewFetcher() = %+v; want the public fetcher with the User-Agent from the environment", f)
+	}
+
+	t.Setenv("COURTSIDE_REDDIT_CLIENT_ID", "app")
+	settings, _ = (&data.Settings{}).Effective()
+	if _, ok := NewFetcher(settings.Reddit).(*OAuthFetcher); !ok {
+		t.Errorf("NewFetcher() with COURTSIDE_REDDIT_CLIENT_ID set; want the OAuth fetcher")
+	}
+}
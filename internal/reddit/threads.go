@@ -0,0 +1,211 @@
+package reddit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel7419/courtside/internal/data"
+)
+
+// Flairs of the discussion threads r/nba runs for every game.
+const (
+	FlairGameThread     = "Game Thread"
+	FlairPostGameThread = "Post Game Thread"
+)
+
+const gameThreadsFileName = "game_threads.json"
+
+// GameThreads holds the URLs of a game's r/nba discussion threads.
+type GameThreads struct {
+	MatchID        int       `json:"match_id"`
+	GameThread     string    `json:"game_thread,omitempty"`
+	PostGameThread string    `json:"post_game_thread,omitempty"`
+	CheckedAt      time.Time `json:"checked_at"` // when Reddit was last searched
+}
+
+// complete reports whether every thread the game can have has been found:
+// the post-game thread is only posted once the game is over.
+func (t GameThreads) complete(finished bool) bool {
+	return t.GameThread != "" && (t.PostGameThread != "" || !finished)
+}
+
+// ThreadInfo contains information about a game to find threads for.
+type ThreadInfo struct {
+	MatchID   int
+	HomeTeam  string
+	AwayTeam  string
+	MatchTime time.Time
+	Finished  bool
+}
+
+// ThreadCache provides persistent storage for game thread links.
+type ThreadCache struct {
+	mu       sync.RWMutex
+	threads  map[string]GameThreads // key: matchID
+	filePath string                 // empty keeps the cache in memory
+}
+
+// NewThreadCache creates a new cache, loading existing data from disk.
+func NewThreadCache() (*ThreadCache, error) {
+	dir, err := data.CacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("get cache dir: %w", err)
+	}
+
+	cache := &ThreadCache{
+		threads:  make(map[string]GameThreads),
+		filePath: filepath.Join(dir, gameThreadsFileName),
+	}
+	// Start with an empty cache if the file is missing or unreadable
+	_ = cache.load()
+	return cache, nil
+}
+
+// newMemoryThreadCache creates a cache that is not saved to disk.
+func newMemoryThreadCache() *ThreadCache {
+	return &ThreadCache{threads: make(map[string]GameThreads)}
+}
+
+// Get returns the cached threads of a game.
+func (c *ThreadCache) Get(matchID int) (GameThreads, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	t, ok := c.threads[strconv.Itoa(matchID)]
+	return t, ok
+}
+
+// Set stores the threads of a game and persists the cache, dropping games
+// older than CacheTTL.
+func (c *ThreadCache) Set(t GameThreads) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, old := range c.threads {
+		if time.Since(old.CheckedAt) > CacheTTL {
+			delete(c.threads, id)
+		}
+	}
+	c.threads[strconv.Itoa(t.MatchID)] = t
+
+	if c.filePath == "" {
+		return nil
+	}
+	b, err := json.MarshalIndent(c.threads, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal thread cache: %w", err)
+	}
+	if err := os.WriteFile(c.filePath, b, 0644); err != nil {
+		return fmt.Errorf("write thread cache: %w", err)
+	}
+	return nil
+}
+
+// load reads the cache from disk.
+func (c *ThreadCache) load() error {
+	b, err := os.ReadFile(c.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read thread cache: %w", err)
+	}
+	if err := json.Unmarshal(b, &c.threads); err != nil {
+		return fmt.Errorf("parse thread cache: %w", err)
+	}
+	return nil
+}
+
+// GameThreads returns the game thread and, once the game is over, the
+// post-game thread of a game. Threads not found yet are searched again after
+// NotFoundTTL; found ones are never searched again.
+func (c *Client) GameThreads(game ThreadInfo) (*GameThreads, error) {
+	cached, ok := c.threads.Get(game.MatchID)
+	if ok && (cached.complete(game.Finished) || time.Since(cached.CheckedAt) < NotFoundTTL) {
+		return &cached, nil
+	}
+
+	threads := cached
+	threads.MatchID = game.MatchID
+	var errs []error
+	if threads.GameThread == "" {
+		url, err := c.searchThread(FlairGameThread, game)
+		threads.GameThread = url
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if threads.PostGameThread == "" && game.Finished {
+		url, err := c.searchThread(FlairPostGameThread, game)
+		threads.PostGameThread = url
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	found := threads.GameThread != cached.GameThread || threads.PostGameThread != cached.PostGameThread
+	if err := errors.Join(errs...); err != nil && !found {
+		// Nothing new and a search failed: don't cache, allow retry
+		return nil, err
+	}
+
+	threads.CheckedAt = time.Now()
+	_ = c.threads.Set(threads) // best-effort
+	return &threads, nil
+}
+
+// searchThread searches r/nba for a game's thread with the given flair and
+// returns its post URL, or "" when there is none yet.
+func (c *Client) searchThread(flair string, game ThreadInfo) (string, error) {
+	if err := c.checkBlocked(); err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf("%s %s", game.AwayTeam, game.HomeTeam)
+	c.debugLog(fmt.Sprintf("Reddit %s search: '%s' for match %d", flair, query, game.MatchID))
+	results, err := c.fetcher.SearchFlair(flair, query, 10, game.MatchTime, "new")
+	if err != nil {
+		c.debugLog(fmt.Sprintf("Reddit %s search failed for match %d: %v", flair, game.MatchID, err))
+		c.noteBlocked(err)
+		return "", err
+	}
+	match := findThread(results, flair, game)
+	if match == nil {
+		return "", nil
+	}
+	c.debugLog(fmt.Sprintf("Found %s for match %d: %s", flair, game.MatchID, match.PostURL))
+	return match.PostURL, nil
+}
+
+// findThread picks the thread of the game among search results: a title
+// naming the kind of thread and both teams, posted closest to tip-off.
+//
+// Titles look like "GAME THREAD: Miami Heat (30-20) @ Boston Celtics (35-15)"
+// and "[Post Game Thread] The Boston Celtics (36-15) defeat the Miami Heat".
+func findThread(results []SearchResult, flair string, game ThreadInfo) *SearchResult {
+	homeNorm := normalizeTeamName(game.HomeTeam)
+	awayNorm := normalizeTeamName(game.AwayTeam)
+
+	var best *SearchResult
+	var bestGap time.Duration
+	for i := range results {
+		result := &results[i]
+		title := strings.ToLower(result.Title)
+		isPostGame := strings.Contains(title, "post game") || strings.Contains(title, "postgame")
+		if !strings.Contains(title, "thread") || isPostGame != (flair == FlairPostGameThread) {
+			continue
+		}
+		if !containsTeamName(title, homeNorm) || !containsTeamName(title, awayNorm) {
+			continue
+		}
+		gap := result.CreatedAt.Sub(game.MatchTime).Abs()
+		if best == nil || gap < bestGap {
+			best, bestGap = result, gap
+		}
+	}
+	return best
+}
@@ -1,25 +1,46 @@
-// Package reddit provides functionality to fetch goal replay links from r/soccer.
+// Package reddit provides functionality to fetch play highlight links from r/nba.
 package reddit
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
-// GoalLink represents a cached goal replay link from Reddit.
-type GoalLink struct {
+// Highlight represents a cached highlight clip of a play from Reddit.
+type Highlight struct {
 	MatchID   int       `json:"match_id"`
-	Minute    int       `json:"minute"`
+	EventID   int       `json:"event_id"`
+	Period    int       `json:"period"`
+	Clock     string    `json:"clock"`
 	URL       string    `json:"url"`
 	Title     string    `json:"title"`
 	PostURL   string    `json:"post_url"`
 	FetchedAt time.Time `json:"fetched_at"`
+
+	Confidence MatchConfidence `json:"confidence,omitempty"` // of the post's title matching the play
+	Upvotes    int             `json:"upvotes,omitempty"`
+}
+
+// Key returns the key of the highlight's play.
+func (h Highlight) Key() PlayKey {
+	return PlayKey{MatchID: h.MatchID, EventID: h.EventID, Period: h.Period, Clock: h.Clock}
 }
 
-// GoalLinkKey creates a unique key for a goal (matchID + minute).
-type GoalLinkKey struct {
+// PlayKey identifies a play: its game, event ID, period and clock.
+type PlayKey struct {
 	MatchID int
-	Minute  int
+	EventID int
+	Period  int
+	Clock   string
 }
 
-// SearchResult represents a Reddit search result from r/soccer.
+// String renders the key as "matchID:eventID:period:clock".
+func (k PlayKey) String() string {
+	return fmt.Sprintf("%d:%d:%d:%s", k.MatchID, k.EventID, k.Period, k.Clock)
+}
+
+// SearchResult represents a Reddit search result from r/nba.
 type SearchResult struct {
 	Title     string
 	URL       string // The media URL (video/gif link)
@@ -38,6 +59,19 @@ type redditSearchResponse struct {
 	} `json:"data"`
 }
 
+// withFlair returns the posts of a search response with the given flair; the
+// search's flair filter also matches similar flairs.
+func (r redditSearchResponse) withFlair(flair string) []SearchResult {
+	results := make([]SearchResult, 0, len(r.Data.Children))
+	for _, child := range r.Data.Children {
+		result := child.Data.toSearchResult()
+		if strings.EqualFold(result.Flair, flair) {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
 // redditPost represents a single post from Reddit's API.
 type redditPost struct {
 	Title         string  `json:"title"`
@@ -85,18 +119,24 @@ func (p *redditPost) toSearchResult() SearchResult {
 	}
 }
 
-// GoalInfo contains information about a goal to search for.
-type GoalInfo struct {
-	MatchID       int
-	HomeTeam      string
-	AwayTeam      string
-	HomeTeamShort string // Short/alternative name (e.g., "Wolves", "Man Utd")
-	AwayTeamShort string // Short/alternative name (e.g., "Wolves", "Man Utd")
-	ScorerName    string
-	Minute        int
-	DisplayMinute string // e.g., "45+2'" for stoppage time display
-	HomeScore     int
-	AwayScore     int
-	IsHomeTeam    bool
-	MatchTime     time.Time
+// PlayInfo contains information about a play to search highlights for.
+type PlayInfo struct {
+	MatchID    int
+	EventID    int
+	Period     int
+	Clock      string // time left in the period, "0:04"
+	HomeTeam   string
+	AwayTeam   string
+	Player     string // as in the play-by-play, "J. Tatum"
+	Kind       string // "game-winner", "milestone", "three", "dunk" or "block"
+	Detail     string // the milestone reached, "40 points"
+	HomeScore  int    // score after the play
+	AwayScore  int
+	IsHomeTeam bool
+	MatchTime  time.Time
+}
+
+// Key returns the key of the play.
+func (p PlayInfo) Key() PlayKey {
+	return PlayKey{MatchID: p.MatchID, EventID: p.EventID, Period: p.Period, Clock: p.Clock}
 }
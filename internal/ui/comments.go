@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/ui/design"
+)
+
+// maxCommentLines is how many lines of a comment's text the panel shows.
+const maxCommentLines = 4
+
+// ThreadComment is a game thread comment shown in the comments panel.
+type ThreadComment struct {
+	Author string
+	Body   string
+	Score  int
+	Time   time.Time
+}
+
+// CommentsPanel is the content of the game thread comments panel.
+type CommentsPanel struct {
+	Comments []ThreadComment // newest first
+	Status   string          // why the comments are missing or stale, if they are
+}
+
+// RenderCommentsPanel renders the newest game thread comments, each under a
+// "u/author · 20:41 · ▲ 12" line, below the panel's status if it has one.
+func RenderCommentsPanel(width, height int, panel CommentsPanel) string {
+	contentWidth := max(width-6, 10)
+	title := design.RenderHeader(constants.PanelGameThread, contentWidth)
+
+	var body string
+	if len(panel.Comments) == 0 {
+		status := panel.Status
+		if status == "" {
+			status = constants.EmptyNoComments
+		}
+		body = neonEmptyStyle.Width(contentWidth).Render(status)
+	} else {
+		authorStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+		textStyle := lipgloss.NewStyle().Foreground(neonWhiteAlt).Width(contentWidth)
+
+		blocks := make([]string, 0, len(panel.Comments))
+		for _, c := range panel.Comments {
+			meta := fmt.Sprintf(" · %s · ▲ %d", c.Time.Local().Format("15:04"), c.Score)
+			header := authorStyle.Render(truncateString("u/"+c.Author, max(contentWidth-lipgloss.Width(meta), 4))) +
+				neonDimStyle.Render(meta)
+
+			text := textStyle.Render(strings.Join(strings.Fields(c.Body), " "))
+			if lines := strings.Split(text, "\n"); len(lines) > maxCommentLines {
+				text = strings.Join(lines[:maxCommentLines], "\n")
+			}
+			blocks = append(blocks, header+"\n"+text)
+		}
+		body = strings.Join(blocks, "\n\n")
+		if panel.Status != "" {
+			body = neonDimStyle.Width(contentWidth).Render(panel.Status) + "\n\n" + body
+		}
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, title, "", body)
+	content = truncateToHeight(content, max(height-2, 1))
+	return neonPanelStyle.Width(width).Height(height).Render(content)
+}
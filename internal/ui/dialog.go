@@ -4,6 +4,7 @@ package ui
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
 )
 
 // Dialog sizing constants (30% larger for better readability).
@@ -18,6 +19,22 @@ type DialogAction any
 // DialogActionClose signals that the dialog should be closed.
 type DialogActionClose struct{}
 
+// DialogActionOpenTeam asks the app to open the team page for TeamID.
+type DialogActionOpenTeam struct {
+	TeamID int
+}
+
+// DialogActionOpenPlayer asks the app to open the profile of a box score player.
+type DialogActionOpenPlayer struct {
+	PlayerID int
+	Name     string
+}
+
+// DialogActionOpenMatch asks the app to close all dialogs and show Match.
+type DialogActionOpenMatch struct {
+	Match api.Match
+}
+
 // Dialog is a component that can be displayed as an overlay on top of the UI.
 type Dialog interface {
 	// ID returns the unique identifier of the dialog.
@@ -78,6 +95,11 @@ func (o *DialogOverlay) CloseFrontDialog() {
 	o.dialogs = o.dialogs[:len(o.dialogs)-1]
 }
 
+// CloseAllDialogs removes every dialog from the stack.
+func (o *DialogOverlay) CloseAllDialogs() {
+	o.dialogs = o.dialogs[:0]
+}
+
 // FrontDialog returns the front (topmost) dialog, or nil if there are no dialogs.
 func (o *DialogOverlay) FrontDialog() Dialog {
 	if len(o.dialogs) == 0 {
@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+const boxScoreDialogID = "boxscore"
+
+// BoxScoreDialog displays the full box score of one team at a time.
+// Selecting a player opens their profile.
+type BoxScoreDialog struct {
+	homeTeam    string
+	awayTeam    string
+	homePlayers []api.PlayerStatLine
+	awayPlayers []api.PlayerStatLine
+	focusedTeam int    // 0 = home, 1 = away
+	cursors     [2]int // selected row per team
+}
+
+// NewBoxScoreDialog creates a new box score dialog for a game.
+func NewBoxScoreDialog(details *api.MatchDetails) *BoxScoreDialog {
+	teamName := func(t api.Team) string {
+		if t.ShortName != "" {
+			return t.ShortName
+		}
+		return t.Name
+	}
+	return &BoxScoreDialog{
+		homeTeam:    teamName(details.HomeTeam),
+		awayTeam:    teamName(details.AwayTeam),
+		homePlayers: details.HomePlayerStats,
+		awayPlayers: details.AwayPlayerStats,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *BoxScoreDialog) ID() string {
+	return boxScoreDialogID
+}
+
+// players returns the rows of the focused team.
+func (d *BoxScoreDialog) players() []api.PlayerStatLine {
+	if d.focusedTeam == 0 {
+		return d.homePlayers
+	}
+	return d.awayPlayers
+}
+
+// Update handles input for the box score dialog.
+func (d *BoxScoreDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "b", "q":
+		return d, DialogActionClose{}
+	case "tab", "h", "l", "left", "right":
+		d.focusedTeam = 1 - d.focusedTeam
+	case "j", "down":
+		if d.cursors[d.focusedTeam] < len(d.players())-1 {
+			d.cursors[d.focusedTeam]++
+		}
+	case "k", "up":
+		if d.cursors[d.focusedTeam] > 0 {
+			d.cursors[d.focusedTeam]--
+		}
+	case "enter":
+		players := d.players()
+		if i := d.cursors[d.focusedTeam]; i < len(players) && players[i].PlayerID != 0 {
+			return d, DialogActionOpenPlayer{PlayerID: players[i].PlayerID, Name: players[i].Name}
+		}
+	}
+	return d, nil
+}
+
+// View renders the box score.
+func (d *BoxScoreDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 90, 30)
+	contentWidth := dialogWidth - 6
+
+	// Team switcher: focused team highlighted
+	home, away := DialogBadge(d.homeTeam), DialogBadge(d.awayTeam)
+	if d.focusedTeam == 0 {
+		home = DialogBadgeHighlight(d.homeTeam)
+	} else {
+		away = DialogBadgeHighlight(d.awayTeam)
+	}
+	switcher := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center).Render(home + "  " + away)
+
+	lines := []string{switcher, "", d.renderHeaderRow(contentWidth), dialogSeparatorStyle.Render(strings.Repeat("─", contentWidth))}
+
+	players := d.players()
+	if len(players) == 0 {
+		lines = append(lines, dialogDimStyle.Render(constants.EmptyNoBoxScore))
+	} else {
+		rows := make([]string, 0, len(players))
+		for i, p := range players {
+			rows = append(rows, d.renderPlayerRow(p, contentWidth, i == d.cursors[d.focusedTeam]))
+		}
+		// Frame padding (2), title bar + spacer (2), help (2) and the header lines above
+		lines = append(lines, scrollRows(rows, d.cursors[d.focusedTeam], dialogHeight-8-len(lines)))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return RenderDialogFrameWithHelp("Box Score", content, constants.HelpBoxScoreDialog, dialogWidth, dialogHeight)
+}
+
+// boxScoreColumns lays out one box score row; the name column takes the remaining width.
+func boxScoreColumns(width int, name, pos, mins, pts, reb, ast, stl, blk, tov, fg, fg3, ft, pm string) string {
+	nameWidth := max(width-68, 12)
+	cell := func(w int, s string) string {
+		return lipgloss.NewStyle().Width(w).Align(lipgloss.Right).Render(s)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		"  ",
+		lipgloss.NewStyle().Width(nameWidth).Render(truncateString(name, nameWidth-1)),
+		lipgloss.NewStyle().Width(3).Render(pos),
+		cell(6, mins), cell(5, pts), cell(5, reb), cell(5, ast), cell(4, stl), cell(4, blk), cell(4, tov),
+		cell(7, fg), cell(6, fg3), cell(6, ft), cell(5, pm),
+	)
+}
+
+// renderHeaderRow renders the box score column legend.
+func (d *BoxScoreDialog) renderHeaderRow(width int) string {
+	return dialogHeaderStyle.Render(boxScoreColumns(width, "Player", "", "MIN", "PTS", "REB", "AST", "STL", "BLK", "TO", "FG", "3PM", "FT", "+/-"))
+}
+
+// renderPlayerRow renders one player's line; the selected row is highlighted.
+func (d *BoxScoreDialog) renderPlayerRow(p api.PlayerStatLine, width int, selected bool) string {
+	pm := fmt.Sprintf("%d", p.PlusMinus)
+	if p.PlusMinus > 0 {
+		pm = "+" + pm
+	}
+	row := boxScoreColumns(width, p.Name, p.Position, p.Minutes,
+		fmt.Sprintf("%d", p.Points), fmt.Sprintf("%d", p.Rebounds), fmt.Sprintf("%d", p.Assists),
+		fmt.Sprintf("%d", p.Steals), fmt.Sprintf("%d", p.Blocks), fmt.Sprintf("%d", p.Turnovers),
+		fmt.Sprintf("%d-%d", p.FGM, p.FGA), fmt.Sprintf("%d", p.FG3M), fmt.Sprintf("%d-%d", p.FTM, p.FTA), pm)
+
+	if selected {
+		return dialogTeamStyle.Background(neonDark).Width(width).Render(row)
+	}
+	return dialogValueStyle.Render(row)
+}
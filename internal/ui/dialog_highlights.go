@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+const highlightsDialogID = "highlights"
+
+// HighlightReelPlay is a notable play listed in the highlights reel with the
+// clips found of it, best first.
+type HighlightReelPlay struct {
+	Time  string // "Q4 0:04"
+	Play  string // "Jayson Tatum game-winner"
+	Clips []HighlightReelClip
+}
+
+// HighlightReelClip is a clip of a play.
+type HighlightReelClip struct {
+	Title   string
+	URL     string
+	PostURL string // discussion page, when the source has one
+	Source  string
+	Upvotes int // Reddit score; 0 when the source has none
+}
+
+// DialogActionOpenURL asks the app to open URL in the browser.
+type DialogActionOpenURL struct {
+	URL string
+}
+
+// DialogActionCopyURL asks the app to copy URL to the clipboard.
+type DialogActionCopyURL struct {
+	URL string
+}
+
+// DialogActionSearchHighlights asks the app to search again for clips of the
+// plays that have none.
+type DialogActionSearchHighlights struct{}
+
+// highlightRow is a line of the reel: a clip of a play, or a play without one
+// when clip is -1.
+type highlightRow struct {
+	play int
+	clip int
+}
+
+// HighlightsDialog lists the clips found of a game's notable plays, in game order.
+type HighlightsDialog struct {
+	title  string
+	plays  []HighlightReelPlay
+	rows   []highlightRow
+	cursor int
+	status string
+}
+
+// NewHighlightsDialog creates a highlights reel titled with the game, over
+// plays in game order.
+func NewHighlightsDialog(title string, plays []HighlightReelPlay) *HighlightsDialog {
+	d := &HighlightsDialog{title: title}
+	d.SetPlays(plays)
+	return d
+}
+
+// ID returns the dialog identifier.
+func (d *HighlightsDialog) ID() string {
+	return highlightsDialogID
+}
+
+// SetPlays replaces the listed plays, as when a search finds more clips,
+// keeping the cursor on the same line where it can.
+func (d *HighlightsDialog) SetPlays(plays []HighlightReelPlay) {
+	d.plays = plays
+	d.rows = d.rows[:0]
+	for i, p := range plays {
+		if len(p.Clips) == 0 {
+			d.rows = append(d.rows, highlightRow{play: i, clip: -1})
+			continue
+		}
+		for j := range p.Clips {
+			d.rows = append(d.rows, highlightRow{play: i, clip: j})
+		}
+	}
+	d.cursor = max(0, min(d.cursor, len(d.rows)-1))
+}
+
+// SetStatus shows a line of status under the title, such as a running search.
+func (d *HighlightsDialog) SetStatus(status string) {
+	d.status = status
+}
+
+// Update handles input for the highlights dialog.
+func (d *HighlightsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q", "v":
+		return d, DialogActionClose{}
+	case "j", "down":
+		if d.cursor < len(d.rows)-1 {
+			d.cursor++
+		}
+	case "k", "up":
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	case "enter", "o":
+		if clip := d.selectedClip(); clip != nil {
+			return d, DialogActionOpenURL{URL: clip.URL}
+		}
+	case "p":
+		if clip := d.selectedClip(); clip != nil && clip.PostURL != "" {
+			return d, DialogActionOpenURL{URL: clip.PostURL}
+		}
+	case "y":
+		if clip := d.selectedClip(); clip != nil {
+			return d, DialogActionCopyURL{URL: clip.URL}
+		}
+	case "r":
+		if d.missing() > 0 {
+			return d, DialogActionSearchHighlights{}
+		}
+	}
+	return d, nil
+}
+
+// selectedClip returns the clip under the cursor, or nil on a play without one.
+func (d *HighlightsDialog) selectedClip() *HighlightReelClip {
+	if d.cursor >= len(d.rows) || d.rows[d.cursor].clip < 0 {
+		return nil
+	}
+	row := d.rows[d.cursor]
+	return &d.plays[row.play].Clips[row.clip]
+}
+
+// missing returns the number of plays without a clip.
+func (d *HighlightsDialog) missing() int {
+	n := 0
+	for _, p := range d.plays {
+		if len(p.Clips) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// View renders the highlights reel.
+func (d *HighlightsDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 110, 36)
+	contentWidth := dialogWidth - 6
+
+	title := "Highlights"
+	if d.title != "" {
+		title += " · " + d.title
+	}
+	if len(d.plays) > 0 {
+		title += fmt.Sprintf(" · %d of %d plays", len(d.plays)-d.missing(), len(d.plays))
+	}
+
+	// Frame padding (2), title bar + spacer (2) and help (2)
+	bodyHeight := max(dialogHeight-8, 3)
+
+	var lines []string
+	if d.status != "" {
+		lines = append(lines, dialogDimStyle.Render(d.status), "")
+		bodyHeight = max(bodyHeight-2, 1)
+	}
+	if len(d.rows) == 0 {
+		lines = append(lines, dialogDimStyle.Render(constants.EmptyNoNotablePlays))
+	} else {
+		rows := make([]string, 0, len(d.rows))
+		for i, row := range d.rows {
+			rows = append(rows, d.renderRow(row, contentWidth, i == d.cursor))
+		}
+		lines = append(lines, scrollRows(rows, d.cursor, bodyHeight))
+	}
+	content := strings.Join(lines, "\n")
+	return RenderDialogFrameWithHelp(title, content, constants.HelpHighlightsDialog, dialogWidth, dialogHeight)
+}
+
+// renderRow renders "▸ Q4 0:04  [Highlight] Tatum game-winner vs Heat   r/nba   ▲ 1.2k".
+// The time is shown on a play's first clip only; a play without clips shows
+// what it was instead of a title.
+func (d *HighlightsDialog) renderRow(row highlightRow, width int, selected bool) string {
+	cursor := "  "
+	if selected {
+		cursor = "▸ "
+	}
+	play := d.plays[row.play]
+
+	when := ""
+	if row.clip <= 0 {
+		when = play.Time
+	}
+
+	fixed := 2 + 10 + 12 + 8
+	titleWidth := max(width-fixed, 10)
+
+	var title, source, score string
+	if row.clip < 0 {
+		title = dialogDimStyle.MaxWidth(titleWidth).Render(play.Play + " — " + constants.EmptyNoClip)
+	} else {
+		clip := play.Clips[row.clip]
+		text := clip.Title
+		if text == "" {
+			text = play.Play
+		}
+		title = dialogValueStyle.MaxWidth(titleWidth).Render(text)
+		source = clip.Source
+		if clip.Upvotes > 0 {
+			score = "▲ " + formatScore(clip.Upvotes)
+		}
+	}
+
+	line := lipgloss.JoinHorizontal(lipgloss.Top,
+		cursor,
+		dialogTeamStyle.Width(10).Render(when),
+		lipgloss.NewStyle().Width(titleWidth).Render(title),
+		dialogDimStyle.Width(12).Render(" "+source),
+		dialogDimStyle.Width(8).Align(lipgloss.Right).Render(score),
+	)
+
+	if selected {
+		return lipgloss.NewStyle().Background(neonDark).Width(width).Render(line)
+	}
+	return line
+}
+
+// formatScore shortens a Reddit score: 950, 1.2k, 15k.
+func formatScore(n int) string {
+	switch {
+	case n >= 10000:
+		return fmt.Sprintf("%dk", n/1000)
+	case n >= 1000:
+		return fmt.Sprintf("%.1fk", float64(n)/1000)
+	}
+	return fmt.Sprintf("%d", n)
+}
@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+const inboxDialogID = "inbox"
+
+// InboxEntry is one fired notification listed in the inbox.
+type InboxEntry struct {
+	ID      int64
+	Time    time.Time
+	Message string // headline and scoreline, one per line
+	Match   api.Match
+	Read    bool
+}
+
+// DialogActionMarkRead asks the app to mark inbox entries read (every entry
+// when IDs is empty), then to open Match when it is set.
+type DialogActionMarkRead struct {
+	IDs   []int64
+	Match *api.Match
+}
+
+// InboxDialog lists past notifications, newest first.
+type InboxDialog struct {
+	entries []InboxEntry
+	cursor  int
+}
+
+// NewInboxDialog creates an inbox over entries, which are newest first.
+func NewInboxDialog(entries []InboxEntry) *InboxDialog {
+	return &InboxDialog{entries: entries}
+}
+
+// ID returns the dialog identifier.
+func (d *InboxDialog) ID() string {
+	return inboxDialogID
+}
+
+// Update handles input for the inbox dialog.
+func (d *InboxDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q", "n":
+		return d, DialogActionClose{}
+	case "j", "down":
+		if d.cursor < len(d.entries)-1 {
+			d.cursor++
+		}
+	case "k", "up":
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	case "enter":
+		if d.cursor < len(d.entries) {
+			e := &d.entries[d.cursor]
+			e.Read = true
+			action := DialogActionMarkRead{IDs: []int64{e.ID}}
+			if e.Match.ID != 0 {
+				match := e.Match
+				action.Match = &match
+			}
+			return d, action
+		}
+	case " ", "r":
+		if d.cursor < len(d.entries) && !d.entries[d.cursor].Read {
+			d.entries[d.cursor].Read = true
+			return d, DialogActionMarkRead{IDs: []int64{d.entries[d.cursor].ID}}
+		}
+	case "a":
+		if d.unread() > 0 {
+			for i := range d.entries {
+				d.entries[i].Read = true
+			}
+			return d, DialogActionMarkRead{}
+		}
+	}
+	return d, nil
+}
+
+// unread returns the number of unread entries.
+func (d *InboxDialog) unread() int {
+	n := 0
+	for _, e := range d.entries {
+		if !e.Read {
+			n++
+		}
+	}
+	return n
+}
+
+// View renders the notification list.
+func (d *InboxDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 96, 36)
+	contentWidth := dialogWidth - 6
+
+	title := "Notifications"
+	if n := d.unread(); n > 0 {
+		title += fmt.Sprintf(" · %d unread", n)
+	}
+
+	// Frame padding (2), title bar + spacer (2) and help (2)
+	bodyHeight := max(dialogHeight-8, 3)
+
+	var content string
+	if len(d.entries) == 0 {
+		content = dialogDimStyle.Render(constants.EmptyNoNotifications)
+	} else {
+		rows := make([]string, 0, len(d.entries))
+		for i, e := range d.entries {
+			rows = append(rows, d.renderRow(e, contentWidth, i == d.cursor))
+		}
+		content = scrollRows(rows, d.cursor, bodyHeight)
+	}
+	return RenderDialogFrameWithHelp(title, content, constants.HelpInboxDialog, dialogWidth, dialogHeight)
+}
+
+// renderRow renders "▸ ● Tue 21:34  MIA @ BOS  BOS on a 12-0 run  Q2 6:01 · BOS 48 - 39 MIA".
+// Unread entries are marked with a dot and shown brighter.
+func (d *InboxDialog) renderRow(e InboxEntry, width int, selected bool) string {
+	cursor := "  "
+	if selected {
+		cursor = "▸ "
+	}
+	marker := "  "
+	textStyle := dialogDimStyle
+	if !e.Read {
+		marker = lipgloss.NewStyle().Foreground(neonCyan).Render("●") + " "
+		textStyle = dialogValueStyle
+	}
+
+	when := e.Time.Local().Format("15:04")
+	if !sameDay(e.Time, time.Now()) {
+		when = e.Time.Local().Format("Mon 15:04")
+	}
+
+	matchup := ""
+	if e.Match.AwayTeam.ShortName != "" || e.Match.HomeTeam.ShortName != "" {
+		matchup = e.Match.AwayTeam.ShortName + " @ " + e.Match.HomeTeam.ShortName
+	}
+
+	fixed := 2 + 2 + 11 + 11
+	message := strings.Join(strings.Split(strings.TrimSpace(e.Message), "\n"), " · ")
+
+	row := lipgloss.JoinHorizontal(lipgloss.Top,
+		cursor,
+		marker,
+		dialogDimStyle.Width(11).Render(when),
+		dialogTeamStyle.Width(11).Render(matchup),
+		textStyle.MaxWidth(max(width-fixed, 10)).Render(message),
+	)
+
+	if selected {
+		return lipgloss.NewStyle().Background(neonDark).Width(width).Render(row)
+	}
+	return row
+}
+
+// sameDay reports whether a and b fall on the same local day.
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Local().Date()
+	by, bm, bd := b.Local().Date()
+	return ay == by && am == bm && ad == bd
+}
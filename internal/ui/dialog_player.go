@@ -0,0 +1,231 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+const playerDialogID = "player"
+
+// PlayerDialog displays a player's bio, season averages, shooting splits
+// and recent game log. The game the dialog was opened from is highlighted.
+type PlayerDialog struct {
+	profile        *api.PlayerProfile
+	currentMatchID int
+	cursor         int // selected game log row
+}
+
+// NewPlayerDialog creates a new player dialog.
+// The game log cursor starts on currentMatchID when it is in the log.
+func NewPlayerDialog(profile *api.PlayerProfile, currentMatchID int) *PlayerDialog {
+	d := &PlayerDialog{profile: profile, currentMatchID: currentMatchID}
+	for i, g := range profile.GameLog {
+		if g.MatchID == currentMatchID {
+			d.cursor = i
+			break
+		}
+	}
+	return d
+}
+
+// ID returns the dialog identifier.
+func (d *PlayerDialog) ID() string {
+	return playerDialogID
+}
+
+// Update handles input for the player dialog.
+func (d *PlayerDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q":
+		return d, DialogActionClose{}
+	case "j", "down":
+		if d.cursor < len(d.profile.GameLog)-1 {
+			d.cursor++
+		}
+	case "k", "up":
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	}
+	return d, nil
+}
+
+// View renders the player profile.
+func (d *PlayerDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 84, 36)
+	contentWidth := dialogWidth - 6
+	center := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center)
+	separator := dialogSeparatorStyle.Render(strings.Repeat("─", contentWidth))
+
+	lines := []string{
+		center.Render(d.renderBio()),
+		center.Render(dialogDimStyle.Render(d.renderBioDetails())),
+		"",
+		dialogHeaderStyle.Render(fmt.Sprintf("%s per game", d.profile.Season)),
+		separator,
+	}
+	lines = append(lines, d.renderAverages(contentWidth)...)
+	lines = append(lines, "", dialogHeaderStyle.Render("Game log"), separator)
+
+	// Frame padding (2), title bar + spacer (2), help (2) and the lines above
+	lines = append(lines, d.renderGameLog(contentWidth, dialogHeight-8-len(lines)))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return RenderDialogFrameWithHelp(d.profile.Name, content, constants.HelpPlayerDialog, dialogWidth, dialogHeight)
+}
+
+// renderBio renders "#0 · Forward · Boston Celtics".
+func (d *PlayerDialog) renderBio() string {
+	var parts []string
+	if d.profile.Jersey != "" {
+		parts = append(parts, "#"+d.profile.Jersey)
+	}
+	if d.profile.Position != "" {
+		parts = append(parts, d.profile.Position)
+	}
+	if d.profile.Team.Name != "" {
+		parts = append(parts, d.profile.Team.Name)
+	}
+	return dialogTeamStyle.Render(strings.Join(parts, " · "))
+}
+
+// renderBioDetails renders "6-8 · 210 lb · 27 yrs · Duke · 8 seasons · Draft 2017 R1 #3".
+func (d *PlayerDialog) renderBioDetails() string {
+	p := d.profile
+	var parts []string
+	if p.Height != "" {
+		parts = append(parts, p.Height)
+	}
+	if p.Weight != "" {
+		parts = append(parts, p.Weight+" lb")
+	}
+	if p.Age > 0 {
+		parts = append(parts, fmt.Sprintf("%d yrs", p.Age))
+	}
+	if p.School != "" {
+		parts = append(parts, p.School)
+	} else if p.Country != "" {
+		parts = append(parts, p.Country)
+	}
+	switch {
+	case p.Experience == 0:
+		parts = append(parts, "Rookie")
+	case p.Experience == 1:
+		parts = append(parts, "1 season")
+	default:
+		parts = append(parts, fmt.Sprintf("%d seasons", p.Experience))
+	}
+	if p.Draft != "" {
+		parts = append(parts, "Draft "+p.Draft)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// renderAverages renders per-game averages and shooting splits.
+func (d *PlayerDialog) renderAverages(width int) []string {
+	a := d.profile.Averages
+	if a == nil {
+		return []string{dialogDimStyle.Render(constants.EmptyNoPlayerAverages)}
+	}
+
+	cell := func(label, value string) string {
+		return lipgloss.JoinVertical(lipgloss.Center,
+			dialogDimStyle.Render(label),
+			dialogValueStyle.Bold(true).Render(value),
+		)
+	}
+	cells := []string{
+		cell("GP", fmt.Sprintf("%d", a.GamesPlayed)),
+		cell("MIN", fmt.Sprintf("%.1f", a.Minutes)),
+		cell("PTS", fmt.Sprintf("%.1f", a.Points)),
+		cell("REB", fmt.Sprintf("%.1f", a.Rebounds)),
+		cell("AST", fmt.Sprintf("%.1f", a.Assists)),
+		cell("STL", fmt.Sprintf("%.1f", a.Steals)),
+		cell("BLK", fmt.Sprintf("%.1f", a.Blocks)),
+		cell("TOV", fmt.Sprintf("%.1f", a.Turnovers)),
+	}
+	cellWidth := max(width/len(cells), 6)
+	for i := range cells {
+		cells[i] = lipgloss.NewStyle().Width(cellWidth).Align(lipgloss.Center).Render(cells[i])
+	}
+
+	split := func(label string, made, attempted, pct float64) string {
+		return dialogLabelStyle.Width(5).Render(label) +
+			dialogValueStyle.Render(fmt.Sprintf("%.1f-%.1f", made, attempted)) + " " +
+			dialogHeaderStyle.Render(fmt.Sprintf("%.1f%%", pct*100))
+	}
+	splits := strings.Join([]string{
+		split("FG", a.FGM, a.FGA, a.FGPct),
+		split("3P", a.FG3M, a.FG3A, a.FG3Pct),
+		split("FT", a.FTM, a.FTA, a.FTPct),
+	}, "    ")
+
+	return []string{
+		lipgloss.JoinHorizontal(lipgloss.Top, cells...),
+		"",
+		lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(splits),
+	}
+}
+
+// renderGameLog renders the game log, scrolled around the cursor.
+// The current game is marked and highlighted.
+func (d *PlayerDialog) renderGameLog(width, height int) string {
+	if len(d.profile.GameLog) == 0 {
+		return dialogDimStyle.Render(constants.EmptyNoGameLog)
+	}
+
+	columns := func(marker, date, matchup, result, mins, pts, reb, ast, fg, fg3, pm string) string {
+		cell := func(w int, s string) string {
+			return lipgloss.NewStyle().Width(w).Align(lipgloss.Right).Render(s)
+		}
+		return lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().Width(2).Render(marker),
+			lipgloss.NewStyle().Width(8).Render(date),
+			lipgloss.NewStyle().Width(14).Render(matchup),
+			lipgloss.NewStyle().Width(3).Render(result),
+			cell(5, mins), cell(5, pts), cell(5, reb), cell(5, ast), cell(8, fg), cell(7, fg3), cell(6, pm),
+		)
+	}
+
+	rows := make([]string, 0, len(d.profile.GameLog))
+	for i, g := range d.profile.GameLog {
+		date := "—"
+		if !g.Date.IsZero() {
+			date = g.Date.Format("Jan 02")
+		}
+		pm := fmt.Sprintf("%d", g.PlusMinus)
+		if g.PlusMinus > 0 {
+			pm = "+" + pm
+		}
+		isCurrent := g.MatchID != 0 && g.MatchID == d.currentMatchID
+		marker := ""
+		if isCurrent {
+			marker = "▸"
+		}
+		row := columns(marker, date, g.Matchup, g.Result, fmt.Sprintf("%d", g.Minutes),
+			fmt.Sprintf("%d", g.Points), fmt.Sprintf("%d", g.Rebounds), fmt.Sprintf("%d", g.Assists),
+			fmt.Sprintf("%d-%d", g.FGM, g.FGA), fmt.Sprintf("%d-%d", g.FG3M, g.FG3A), pm)
+
+		switch {
+		case i == d.cursor:
+			rows = append(rows, dialogTeamStyle.Background(neonDark).Width(width).Render(row))
+		case isCurrent:
+			rows = append(rows, dialogTeamStyle.Render(row))
+		default:
+			rows = append(rows, dialogValueStyle.Render(row))
+		}
+	}
+
+	header := dialogHeaderStyle.Render(columns("", "Date", "Matchup", "", "MIN", "PTS", "REB", "AST", "FG", "3P", "+/-"))
+	return lipgloss.JoinVertical(lipgloss.Left, header, scrollRows(rows, d.cursor, height-1))
+}
@@ -18,6 +18,7 @@ type StandingsDialog struct {
 	standings   []api.LeagueTableEntry
 	homeTeamID  int
 	awayTeamID  int
+	favorites   map[int]bool // team IDs of favorite teams
 	scrollIndex int
 }
 
@@ -32,6 +33,14 @@ func NewStandingsDialog(leagueName string, standings []api.LeagueTableEntry, hom
 	}
 }
 
+// SetFavorites marks the teams with the given IDs as favorites.
+func (d *StandingsDialog) SetFavorites(teamIDs []int) {
+	d.favorites = make(map[int]bool, len(teamIDs))
+	for _, id := range teamIDs {
+		d.favorites[id] = true
+	}
+}
+
 // ID returns the dialog identifier.
 func (d *StandingsDialog) ID() string {
 	return standingsDialogID
@@ -52,6 +61,10 @@ func (d *StandingsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 			if d.scrollIndex > 0 {
 				d.scrollIndex--
 			}
+		case "enter":
+			if d.scrollIndex < len(d.standings) && d.standings[d.scrollIndex].Team.ID != 0 {
+				return d, DialogActionOpenTeam{TeamID: d.standings[d.scrollIndex].Team.ID}
+			}
 		}
 	}
 	return d, nil
@@ -60,7 +73,8 @@ func (d *StandingsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 // View renders the standings table.
 func (d *StandingsDialog) View(width, height int) string {
 	dialogWidth, dialogHeight := DialogSize(width, height, 90, 36)
-	content := d.renderTable(dialogWidth - 6)
+	// Frame padding (2), title bar + spacer (2) and help (2)
+	content := d.renderTable(dialogWidth-6, dialogHeight-8)
 	return RenderDialogFrameWithHelp(d.leagueName+" Standings", content, constants.HelpStandingsDialog, dialogWidth, dialogHeight)
 }
 
@@ -75,12 +89,15 @@ const (
 	nbColStreak = 6  // W3 / L2
 )
 
-func (d *StandingsDialog) renderTable(width int) string {
+// renderTable renders the standings, scrolled so the selected row stays
+// within height lines. The column header is always shown.
+func (d *StandingsDialog) renderTable(width, height int) string {
 	if len(d.standings) == 0 {
 		return dialogDimStyle.Render("No standings data available")
 	}
 
 	var lines []string
+	selectedLine := 0
 
 	// Conference group headers (East / West)
 	var prevConf string
@@ -89,7 +106,7 @@ func (d *StandingsDialog) renderTable(width int) string {
 	lines = append(lines, d.renderHeaderRow(width))
 	lines = append(lines, dialogSeparatorStyle.Render(strings.Repeat("─", width)))
 
-	for _, entry := range d.standings {
+	for i, entry := range d.standings {
 		// Parse conference from Note field ("East | GB: 3.5")
 		conf := ""
 		if parts := strings.SplitN(entry.Note, " | ", 2); len(parts) == 2 {
@@ -112,7 +129,19 @@ func (d *StandingsDialog) renderTable(width int) string {
 			first = false
 		}
 
-		lines = append(lines, d.renderTeamRow(entry, width))
+		if i == d.scrollIndex {
+			selectedLine = len(lines)
+		}
+		lines = append(lines, d.renderTeamRow(entry, width, i == d.scrollIndex))
+	}
+
+	// Keep the two header lines pinned and scroll the body around the cursor
+	const headerLines = 2
+	bodyHeight := height - headerLines
+	if bodyHeight > 0 && len(lines)-headerLines > bodyHeight {
+		start := selectedLine - bodyHeight/2
+		start = max(headerLines, min(start, len(lines)-bodyHeight))
+		lines = append(lines[:headerLines:headerLines], lines[start:start+bodyHeight]...)
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -133,16 +162,21 @@ func (d *StandingsDialog) renderHeaderRow(width int) string {
 }
 
 // renderTeamRow renders a single team row with NBA columns.
-func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) string {
+// The selected row is marked with a cursor.
+func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int, selected bool) string {
 	isHighlighted := entry.Team.ID == d.homeTeamID || entry.Team.ID == d.awayTeamID
+	isFavorite := d.favorites[entry.Team.ID]
 
 	// Team display: prefer abbreviation
 	teamName := entry.Team.ShortName
 	if teamName == "" {
 		teamName = entry.Team.Name
 	}
-	if len(teamName) > nbColTeam-1 {
-		teamName = teamName[:nbColTeam-2] + "…"
+	if len(teamName) > nbColTeam-3 {
+		teamName = teamName[:nbColTeam-4] + "…"
+	}
+	if isFavorite {
+		teamName += " " + FavoriteMarker
 	}
 
 	// Win percentage from PointsFor (stored as win% × 1000)
@@ -170,9 +204,14 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 		streak = "—"
 	}
 
+	cursor := "  "
+	if selected {
+		cursor = "▸ "
+	}
+
 	rowContent := lipgloss.JoinHorizontal(lipgloss.Top,
 		lipgloss.NewStyle().Width(nbColPos).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Position)),
-		"  ",
+		cursor,
 		lipgloss.NewStyle().Width(nbColTeam).Align(lipgloss.Left).Render(teamName),
 		lipgloss.NewStyle().Width(nbColW).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Won)),
 		lipgloss.NewStyle().Width(nbColL).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Lost)),
@@ -190,6 +229,14 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 			Render(rowContent)
 	}
 
+	if selected {
+		return dialogTeamStyle.Render(rowContent)
+	}
+
+	if isFavorite {
+		return favoriteStyle.Render(rowContent)
+	}
+
 	return dialogValueStyle.Render(rowContent)
 }
 
@@ -0,0 +1,413 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+const teamDialogID = "team"
+
+// Team page tabs
+const (
+	teamTabSchedule = iota
+	teamTabRecent
+	teamTabRoster
+	teamTabAverages
+	teamTabCount
+)
+
+// recentGamesCount is the number of finished games shown on the "Last 10" tab.
+const recentGamesCount = 10
+
+// TeamDialog displays a team's season: full schedule, recent results,
+// roster and per-game averages with league ranks.
+type TeamDialog struct {
+	profile *api.TeamProfile
+	recent  []api.Match // last finished games, newest first
+	tab     int
+	cursors [teamTabCount]int // selected row per tab
+}
+
+// NewTeamDialog creates a new team dialog.
+// The schedule cursor starts on the next game to be played.
+func NewTeamDialog(profile *api.TeamProfile) *TeamDialog {
+	d := &TeamDialog{profile: profile}
+
+	next := len(profile.Schedule) - 1
+	for i, m := range profile.Schedule {
+		if m.Status != api.MatchStatusFinished {
+			next = i
+			break
+		}
+	}
+	d.cursors[teamTabSchedule] = max(next, 0)
+
+	for i := len(profile.Schedule) - 1; i >= 0 && len(d.recent) < recentGamesCount; i-- {
+		if profile.Schedule[i].Status == api.MatchStatusFinished {
+			d.recent = append(d.recent, profile.Schedule[i])
+		}
+	}
+
+	return d
+}
+
+// ID returns the dialog identifier.
+func (d *TeamDialog) ID() string {
+	return teamDialogID
+}
+
+// Update handles input for the team dialog.
+func (d *TeamDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q":
+		return d, DialogActionClose{}
+	case "tab", "l", "right":
+		d.tab = (d.tab + 1) % teamTabCount
+	case "shift+tab", "h", "left":
+		d.tab = (d.tab + teamTabCount - 1) % teamTabCount
+	case "j", "down":
+		if d.cursors[d.tab] < d.rowCount()-1 {
+			d.cursors[d.tab]++
+		}
+	case "k", "up":
+		if d.cursors[d.tab] > 0 {
+			d.cursors[d.tab]--
+		}
+	case "enter":
+		if games := d.tabGames(); d.cursors[d.tab] < len(games) {
+			return d, DialogActionOpenMatch{Match: games[d.cursors[d.tab]]}
+		}
+	}
+	return d, nil
+}
+
+// tabGames returns the games listed on the current tab, or nil for non-game tabs.
+func (d *TeamDialog) tabGames() []api.Match {
+	switch d.tab {
+	case teamTabSchedule:
+		return d.profile.Schedule
+	case teamTabRecent:
+		return d.recent
+	}
+	return nil
+}
+
+// rowCount returns the number of selectable rows on the current tab.
+func (d *TeamDialog) rowCount() int {
+	switch d.tab {
+	case teamTabRoster:
+		return len(d.profile.Roster)
+	case teamTabAverages:
+		return len(d.profile.Averages)
+	}
+	return len(d.tabGames())
+}
+
+// View renders the team page.
+func (d *TeamDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 84, 36)
+	contentWidth := dialogWidth - 6
+
+	title := d.profile.Team.Name
+	if title == "" {
+		title = d.profile.Team.ShortName
+	}
+
+	summary := fmt.Sprintf("%s season · %d-%d", d.profile.Season, d.profile.Wins, d.profile.Losses)
+	if len(d.recent) > 0 {
+		w, l := d.recentRecord()
+		summary += fmt.Sprintf(" · Last %d: %d-%d", len(d.recent), w, l)
+	}
+	if streak := d.streak(); streak != "" {
+		summary += " · Streak " + streak
+	}
+
+	header := []string{
+		dialogDimStyle.Width(contentWidth).Align(lipgloss.Center).Render(summary),
+		"",
+		d.renderTabs(contentWidth),
+		dialogSeparatorStyle.Render(strings.Repeat("─", contentWidth)),
+	}
+
+	// Frame padding (2), title bar + spacer (2), help (2) and the header above
+	bodyHeight := max(dialogHeight-8-len(header), 3)
+
+	var body string
+	switch d.tab {
+	case teamTabSchedule:
+		body = d.renderGames(d.profile.Schedule, contentWidth, bodyHeight, constants.EmptyNoScheduledGames)
+	case teamTabRecent:
+		body = d.renderGames(d.recent, contentWidth, bodyHeight, constants.EmptyNoRecentResults)
+	case teamTabRoster:
+		body = d.renderRoster(contentWidth, bodyHeight)
+	case teamTabAverages:
+		body = d.renderAverages(contentWidth, bodyHeight)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, append(header, body)...)
+	return RenderDialogFrameWithHelp(title, content, constants.HelpTeamDialog, dialogWidth, dialogHeight)
+}
+
+// renderTabs renders the tab bar with the active tab highlighted.
+func (d *TeamDialog) renderTabs(width int) string {
+	labels := []string{constants.TeamTabSchedule, constants.TeamTabRecent, constants.TeamTabRoster, constants.TeamTabAverages}
+	tabs := make([]string, 0, len(labels))
+	for i, label := range labels {
+		if i == d.tab {
+			tabs = append(tabs, DialogBadgeHighlight(label))
+		} else {
+			tabs = append(tabs, DialogBadge(label))
+		}
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(strings.Join(tabs, " "))
+}
+
+// renderGames renders a list of games, one per row, scrolled around the cursor.
+func (d *TeamDialog) renderGames(games []api.Match, width, height int, empty string) string {
+	if len(games) == 0 {
+		return dialogDimStyle.Render(empty)
+	}
+
+	rows := make([]string, 0, len(games))
+	for i, m := range games {
+		rows = append(rows, d.renderGameRow(m, width, i == d.cursors[d.tab]))
+	}
+	return scrollRows(rows, d.cursors[d.tab], height)
+}
+
+// renderGameRow renders "Tue 21 Oct   vs NYK   W 112-104" for played games
+// and the tip-off time and TV for upcoming ones.
+func (d *TeamDialog) renderGameRow(m api.Match, width int, selected bool) string {
+	teamID := d.profile.Team.ID
+
+	date := "TBD"
+	if m.MatchTime != nil {
+		date = m.MatchTime.Local().Format("Mon 02 Jan")
+	}
+
+	opponent, venue := m.AwayTeam, "vs"
+	if m.AwayTeam.ID == teamID {
+		opponent, venue = m.HomeTeam, "@ "
+	}
+	opponentName := opponent.ShortName
+	if opponentName == "" {
+		opponentName = opponent.Name
+	}
+
+	var result string
+	resultStyle := dialogValueStyle
+	switch m.Status {
+	case api.MatchStatusFinished, api.MatchStatusLive:
+		own, opp := 0, 0
+		if m.HomeScore != nil && m.AwayScore != nil {
+			own, opp = *m.HomeScore, *m.AwayScore
+			if m.AwayTeam.ID == teamID {
+				own, opp = opp, own
+			}
+		}
+		switch {
+		case m.Status == api.MatchStatusLive:
+			result = fmt.Sprintf("%s %d-%d", constants.StatusLive, own, opp)
+			resultStyle = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+		case own > opp:
+			result = fmt.Sprintf("W %d-%d", own, opp)
+			resultStyle = dialogHeaderStyle
+		default:
+			result = fmt.Sprintf("L %d-%d", own, opp)
+			resultStyle = dialogDimStyle
+		}
+	default:
+		result = "TBD"
+		if m.MatchTime != nil {
+			result = m.MatchTime.Local().Format("15:04")
+		}
+		if len(m.Broadcasters) > 0 {
+			result += "  " + m.Broadcasters[0]
+		}
+	}
+
+	cursor := "  "
+	if selected {
+		cursor = "▸ "
+	}
+
+	row := lipgloss.JoinHorizontal(lipgloss.Top,
+		cursor,
+		lipgloss.NewStyle().Width(12).Render(date),
+		lipgloss.NewStyle().Width(4).Render(venue),
+		lipgloss.NewStyle().Width(6).Render(opponentName),
+		resultStyle.Render(result),
+	)
+
+	if selected {
+		return dialogTeamStyle.Background(neonDark).Width(width).Render(row)
+	}
+	return dialogValueStyle.Render(row)
+}
+
+// renderRoster renders the roster table.
+func (d *TeamDialog) renderRoster(width, height int) string {
+	if len(d.profile.Roster) == 0 {
+		return dialogDimStyle.Render(constants.EmptyNoRoster)
+	}
+
+	nameWidth := max(width-36, 14)
+	columns := func(num, name, pos, ht, wt, age, exp string) string {
+		return lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().Width(6).Align(lipgloss.Right).Render(num),
+			"  ",
+			lipgloss.NewStyle().Width(nameWidth).Render(truncateString(name, nameWidth-1)),
+			lipgloss.NewStyle().Width(6).Render(pos),
+			lipgloss.NewStyle().Width(6).Align(lipgloss.Right).Render(ht),
+			lipgloss.NewStyle().Width(6).Align(lipgloss.Right).Render(wt),
+			lipgloss.NewStyle().Width(5).Align(lipgloss.Right).Render(age),
+			lipgloss.NewStyle().Width(5).Align(lipgloss.Right).Render(exp),
+		)
+	}
+
+	rows := make([]string, 0, len(d.profile.Roster))
+	for i, p := range d.profile.Roster {
+		num := "#" + p.Number
+		if p.Number == "" {
+			num = "—"
+		}
+		age := "—"
+		if p.Age > 0 {
+			age = fmt.Sprintf("%d", p.Age)
+		}
+		row := columns(num, p.Name, p.Position, p.Height, p.Weight, age, p.Experience)
+		if i == d.cursors[d.tab] {
+			rows = append(rows, dialogTeamStyle.Background(neonDark).Width(width).Render(row))
+		} else {
+			rows = append(rows, dialogValueStyle.Render(row))
+		}
+	}
+
+	header := dialogHeaderStyle.Render(columns("#", "Player", "Pos", "Ht", "Wt", "Age", "Exp"))
+	return lipgloss.JoinVertical(lipgloss.Left, header, scrollRows(rows, d.cursors[d.tab], height-1))
+}
+
+// renderAverages renders per-game averages with league ranks.
+// Top-five ranks are highlighted.
+func (d *TeamDialog) renderAverages(width, height int) string {
+	if len(d.profile.Averages) == 0 {
+		return dialogDimStyle.Render(constants.EmptyNoTeamAverages)
+	}
+
+	labelWidth := max(width-28, 14)
+	rows := make([]string, 0, len(d.profile.Averages))
+	for i, s := range d.profile.Averages {
+		value := fmt.Sprintf("%.1f", s.Value)
+		if strings.HasSuffix(s.Label, "%") {
+			value = fmt.Sprintf("%.1f%%", s.Value*100)
+		} else if s.Key == "plus_minus" && s.Value > 0 {
+			value = "+" + value
+		}
+
+		rank := "—"
+		rankStyle := dialogDimStyle
+		if s.Rank > 0 {
+			rank = ordinal(s.Rank)
+			if s.Rank <= 5 {
+				rankStyle = dialogHeaderStyle
+			}
+		}
+
+		row := lipgloss.JoinHorizontal(lipgloss.Top,
+			"  ",
+			lipgloss.NewStyle().Width(labelWidth).Render(s.Label),
+			lipgloss.NewStyle().Width(10).Align(lipgloss.Right).Render(value),
+			rankStyle.Width(10).Align(lipgloss.Right).Render(rank),
+		)
+		if i == d.cursors[d.tab] {
+			rows = append(rows, dialogTeamStyle.Background(neonDark).Width(width).Render(row))
+		} else {
+			rows = append(rows, dialogValueStyle.Render(row))
+		}
+	}
+
+	header := dialogHeaderStyle.Render(lipgloss.JoinHorizontal(lipgloss.Top,
+		"  ",
+		lipgloss.NewStyle().Width(labelWidth).Render("Per game"),
+		lipgloss.NewStyle().Width(10).Align(lipgloss.Right).Render("Value"),
+		lipgloss.NewStyle().Width(10).Align(lipgloss.Right).Render("Rank"),
+	))
+	return lipgloss.JoinVertical(lipgloss.Left, header, scrollRows(rows, d.cursors[d.tab], height-1))
+}
+
+// recentRecord returns wins and losses over the recent games.
+func (d *TeamDialog) recentRecord() (wins, losses int) {
+	for _, m := range d.recent {
+		if teamWon(m, d.profile.Team.ID) {
+			wins++
+		} else {
+			losses++
+		}
+	}
+	return wins, losses
+}
+
+// streak returns the current win/loss streak such as "W3", or "".
+func (d *TeamDialog) streak() string {
+	if len(d.recent) == 0 {
+		return ""
+	}
+	won := teamWon(d.recent[0], d.profile.Team.ID)
+	n := 0
+	for _, m := range d.recent {
+		if teamWon(m, d.profile.Team.ID) != won {
+			break
+		}
+		n++
+	}
+	if won {
+		return fmt.Sprintf("W%d", n)
+	}
+	return fmt.Sprintf("L%d", n)
+}
+
+// teamWon reports whether teamID won a finished game.
+func teamWon(m api.Match, teamID int) bool {
+	if m.HomeScore == nil || m.AwayScore == nil {
+		return false
+	}
+	if m.HomeTeam.ID == teamID {
+		return *m.HomeScore > *m.AwayScore
+	}
+	return *m.AwayScore > *m.HomeScore
+}
+
+// scrollRows returns at most height rows, scrolled so that the cursor row is visible.
+func scrollRows(rows []string, cursor, height int) string {
+	if height > 0 && len(rows) > height {
+		start := max(0, min(cursor-height/2, len(rows)-height))
+		rows = rows[start : start+height]
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// ordinal formats a rank as "1st", "2nd", "23rd".
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -6,6 +6,9 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/muesli/termenv"
 )
 
 // OSC 8 hyperlink escape sequences for terminal hyperlinks.
@@ -172,3 +175,16 @@ func IsValidReplayURL(url string) bool {
 	}
 	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")
 }
+
+// CopyToClipboard copies text to the system clipboard. Where there is no
+// clipboard tool, as over SSH, it asks the terminal to copy it instead
+// (OSC 52), which most modern terminals support.
+func CopyToClipboard(text string) error {
+	if err := clipboard.WriteAll(text); err != nil {
+		if !supportsHyperlinks() {
+			return fmt.Errorf("copy to clipboard: %w", err)
+		}
+		termenv.Copy(text)
+	}
+	return nil
+}
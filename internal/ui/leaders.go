@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/ui/design"
+)
+
+// leaderCategoryLabels maps leagueleaders stat categories to tab labels and
+// the column header of the value.
+var leaderCategoryLabels = map[string][2]string{
+	"PTS":     {"PTS", "Points"},
+	"REB":     {"REB", "Rebounds"},
+	"AST":     {"AST", "Assists"},
+	"STL":     {"STL", "Steals"},
+	"BLK":     {"BLK", "Blocks"},
+	"FG_PCT":  {"FG%", "FG%"},
+	"FG3_PCT": {"3P%", "3P%"},
+	"FT_PCT":  {"FT%", "FT%"},
+}
+
+// leaderModeLabels maps leagueleaders modes to display labels.
+var leaderModeLabels = map[string]string{
+	"PerGame": "Per game",
+	"Totals":  "Totals",
+	"Per36":   "Per 36 min",
+}
+
+// LeadersViewConfig holds all parameters for rendering the leaders view.
+type LeadersViewConfig struct {
+	Width, Height  int
+	Categories     []string // leagueleaders stat categories, in tab order
+	Category       string   // selected category
+	Mode           string   // "PerGame", "Totals" or "Per36"
+	Season         string   // "2025-26"
+	Playoffs       bool
+	Leaders        []api.LeagueLeader
+	PlayingTonight map[int]bool // team IDs with a game today that has not finished
+	Cursor         int
+
+	Spinner    *RandomCharSpinner
+	Loading    bool
+	Failed     bool // last fetch failed and nothing is cached
+	BannerType constants.StatusBannerType
+}
+
+// RenderLeadersView renders the league leaders view: category tabs, the
+// season/mode selector and the ranked table. Players whose team plays today
+// are highlighted.
+func RenderLeadersView(cfg LeadersViewConfig) string {
+	width, height := cfg.Width, cfg.Height
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	spinnerHeight := 3
+	spinnerStyle := lipgloss.NewStyle().
+		Width(width).
+		Height(spinnerHeight).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	var spinnerArea string
+	if cfg.Loading && cfg.Spinner != nil {
+		if spinnerView := cfg.Spinner.View(); spinnerView != "" {
+			spinnerArea = spinnerStyle.Render(spinnerView)
+		} else {
+			spinnerArea = spinnerStyle.Render("Loading...")
+		}
+	} else {
+		spinnerArea = spinnerStyle.Render("")
+	}
+
+	statusBanner := renderStatusBanner(cfg.BannerType, width)
+	panelHeight := max(height-spinnerHeight-lipgloss.Height(statusBanner)-2, minPanelHeight)
+	if statusBanner == "" {
+		panelHeight = max(height-spinnerHeight-2, minPanelHeight)
+	}
+	panelWidth := min(width, 100)
+	contentWidth := panelWidth - 6
+
+	lines := []string{
+		design.RenderHeader(constants.PanelLeaders, contentWidth),
+		"",
+		renderLeaderTabs(cfg.Categories, cfg.Category, contentWidth),
+		renderLeadersSelector(cfg, contentWidth),
+		neonDimStyle.Width(contentWidth).Align(lipgloss.Center).Render(constants.HelpLeadersView),
+		"",
+	}
+
+	emptyStyle := neonEmptyStyle.Width(contentWidth)
+	switch {
+	case len(cfg.Leaders) == 0 && cfg.Loading:
+		lines = append(lines, emptyStyle.Render(constants.LoadingFetching))
+	case len(cfg.Leaders) == 0 && cfg.Failed:
+		lines = append(lines, emptyStyle.Render(constants.EmptyLeadersUnavailable))
+	case len(cfg.Leaders) == 0:
+		lines = append(lines, emptyStyle.Render(constants.EmptyNoLeaders))
+	default:
+		// Panel border (2), the lines above, table header and legend (3)
+		lines = append(lines, renderLeadersTable(cfg, contentWidth, panelHeight-2-len(lines)-3)...)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	if innerHeight := panelHeight - 2; innerHeight > 0 {
+		content = truncateToHeight(content, innerHeight)
+	}
+	panel := neonPanelStyle.Width(panelWidth).Height(panelHeight).Render(content)
+	panel = lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(panel)
+
+	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panel)
+}
+
+// renderLeaderTabs renders the category tabs with the selected one highlighted.
+func renderLeaderTabs(categories []string, selected string, width int) string {
+	tabs := make([]string, 0, len(categories))
+	for _, c := range categories {
+		style := lipgloss.NewStyle().Foreground(neonDim).Padding(0, 1)
+		if c == selected {
+			style = lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Underline(true).Padding(0, 1)
+		}
+		tabs = append(tabs, style.Render(leaderCategoryLabels[c][0]))
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
+}
+
+// renderLeadersSelector renders "‹ 2025-26 ›  Regular Season  Per game".
+func renderLeadersSelector(cfg LeadersViewConfig, width int) string {
+	seasonType := "Regular Season"
+	if cfg.Playoffs {
+		seasonType = "Playoffs"
+	}
+	text := neonDimStyle.Render("‹ ") + neonDateSelectedStyle.Render(cfg.Season) + neonDimStyle.Render(" ›") +
+		neonDateUnselectedStyle.Render("·") + neonValueStyle.Render(seasonType) +
+		neonDateUnselectedStyle.Render("·") + neonValueStyle.Render(leaderModeLabels[cfg.Mode])
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(text)
+}
+
+// renderLeadersTable renders the header, the rows scrolled around the cursor
+// and the "playing today" legend.
+func renderLeadersTable(cfg LeadersViewConfig, width, height int) []string {
+	shooting := strings.HasSuffix(cfg.Category, "_PCT")
+	totals := cfg.Mode == "Totals"
+
+	columns := func(marker, rank, name, team, gp, mins, shots, value string) string {
+		cell := func(w int, s string) string {
+			return lipgloss.NewStyle().Width(w).Align(lipgloss.Right).Render(s)
+		}
+		nameWidth := max(width-52, 16)
+		return lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().Width(2).Render(marker),
+			cell(4, rank), "  ",
+			lipgloss.NewStyle().Width(nameWidth).Render(truncateString(name, nameWidth-1)),
+			lipgloss.NewStyle().Width(5).Render(team),
+			cell(5, gp), cell(8, mins), cell(14, shots), cell(10, value),
+		)
+	}
+
+	format := func(v float64) string {
+		if totals {
+			return fmt.Sprintf("%.0f", v)
+		}
+		return fmt.Sprintf("%.1f", v)
+	}
+
+	rows := make([]string, 0, len(cfg.Leaders))
+	for i, l := range cfg.Leaders {
+		marker := ""
+		if cfg.PlayingTonight[l.Team.ID] {
+			marker = "●"
+		}
+		if i == cfg.Cursor {
+			marker = "▸"
+		}
+
+		value := format(l.Value)
+		shots := ""
+		if shooting {
+			value = fmt.Sprintf("%.1f%%", l.Value*100)
+			shots = format(l.Made) + "-" + format(l.Attempted)
+		}
+
+		row := columns(marker, fmt.Sprintf("%d", l.Rank), l.Name, l.Team.ShortName,
+			fmt.Sprintf("%d", l.GamesPlayed), format(l.Minutes), shots, value)
+
+		switch {
+		case i == cfg.Cursor:
+			rows = append(rows, neonTeamStyle.Background(neonDark).Width(width).Render(row))
+		case cfg.PlayingTonight[l.Team.ID]:
+			rows = append(rows, neonTeamStyle.Render(row))
+		default:
+			rows = append(rows, neonValueStyle.Render(row))
+		}
+	}
+
+	shotsHeader := ""
+	if shooting {
+		shotsHeader = "Made-Att"
+	}
+	header := neonHeaderStyle.Render(columns("", "#", "Player", "Team", "GP", "MIN", shotsHeader, leaderCategoryLabels[cfg.Category][1]))
+	legend := neonDimStyle.Render("  " + constants.LegendPlayingToday)
+
+	return []string{header, scrollRows(rows, cfg.Cursor, height), "", legend}
+}
@@ -67,18 +67,18 @@ func NewMatchListDelegate() list.DefaultDelegate {
 	return d
 }
 
-// LeagueListDelegate is a custom delegate that renders checkboxes separately from titles.
+// SettingsListDelegate is a custom delegate that renders checkboxes separately from titles.
 // This fixes the filter cursor positioning issue by keeping the checkbox out of the title.
-type LeagueListDelegate struct {
+type SettingsListDelegate struct {
 	list.DefaultDelegate
 }
 
-// Render renders a league list item with a checkbox prefix.
+// Render renders a settings list item with a checkbox prefix.
 // The checkbox is rendered separately from the title to prevent filter cursor shift.
-func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
-	leagueItem, ok := item.(LeagueListItem)
+func (d SettingsListDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	settingsItem, ok := item.(SettingsListItem)
 	if !ok {
-		// Fallback: render without checkbox if not a LeagueListItem
+		// Fallback: render without checkbox if not a SettingsListItem
 		// This shouldn't happen in normal usage, but handle gracefully
 		title := item.FilterValue()
 		desc := ""
@@ -104,14 +104,14 @@ func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item li
 
 	// Get checkbox state
 	checkbox := "[ ]"
-	if leagueItem.Selected {
+	if settingsItem.Selected {
 		checkbox = "[x]"
 	}
 
 	// Check if item matches filter by comparing filter value with item's FilterValue
 	filterValue := m.FilterValue()
 	isFiltering := m.FilterState() == list.Filtering
-	isDimmed := isFiltering && filterValue != "" && !d.itemMatchesFilter(leagueItem, filterValue)
+	isDimmed := isFiltering && filterValue != "" && !d.itemMatchesFilter(settingsItem, filterValue)
 
 	// Render checkbox with appropriate styling based on selection and filter state
 	var checkboxStyle lipgloss.Style
@@ -134,8 +134,8 @@ func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item li
 	checkboxRendered := checkboxStyle.Render(checkbox + " ")
 
 	// Get the title and description from the item
-	title := leagueItem.Title()
-	desc := leagueItem.Description()
+	title := settingsItem.Title()
+	desc := settingsItem.Description()
 
 	// Apply appropriate styles based on selection and filter state
 	var titleStyle, descStyle lipgloss.Style
@@ -169,7 +169,7 @@ func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item li
 }
 
 // itemMatchesFilter checks if an item matches the filter value.
-func (d LeagueListDelegate) itemMatchesFilter(item LeagueListItem, filterValue string) bool {
+func (d SettingsListDelegate) itemMatchesFilter(item SettingsListItem, filterValue string) bool {
 	if filterValue == "" {
 		return true
 	}
@@ -179,7 +179,7 @@ func (d LeagueListDelegate) itemMatchesFilter(item LeagueListItem, filterValue s
 }
 
 // HighlightMatches highlights matching text in the title using FilterMatch style.
-func (d LeagueListDelegate) HighlightMatches(text, filterValue string) string {
+func (d SettingsListDelegate) HighlightMatches(text, filterValue string) string {
 	if filterValue == "" {
 		return text
 	}
@@ -235,16 +235,16 @@ func (d LeagueListDelegate) HighlightMatches(text, filterValue string) string {
 	return result.String()
 }
 
-// NewLeagueListDelegate creates a custom list delegate for league selection.
-// Height is set to 2 to show league name (with checkbox) and country.
+// NewSettingsListDelegate creates a custom list delegate for the settings view.
+// Height is set to 2 to show the name (with checkbox) and its detail.
 // Uses same red/cyan neon styling as match delegate for consistency.
 // The checkbox is rendered separately from the title to fix filter cursor positioning.
-func NewLeagueListDelegate() LeagueListDelegate {
-	d := LeagueListDelegate{
+func NewSettingsListDelegate() SettingsListDelegate {
+	d := SettingsListDelegate{
 		DefaultDelegate: list.NewDefaultDelegate(),
 	}
 
-	// Set height to 2 lines: title with checkbox (1) + country (1)
+	// Set height to 2 lines: title with checkbox (1) + detail (1)
 	d.SetHeight(2)
 
 	// Selected items: Neon red title, cyan description, red left border
@@ -2,7 +2,6 @@ package ui
 
 import (
 	"github.com/gabriel7419/courtside/internal/api"
-	"github.com/gabriel7419/courtside/internal/data"
 	"github.com/charmbracelet/bubbles/list"
 )
 
@@ -12,25 +11,28 @@ type MatchListItem struct {
 	Display MatchDisplay
 }
 
-// LeagueListItem implements the list.Item interface for league selection.
-type LeagueListItem struct {
-	League   data.LeagueInfo
+// SettingsListItem implements the list.Item interface for a checkbox of the
+// settings view: a favorite team or a conference filter.
+type SettingsListItem struct {
+	Key      string // team tricode or conference
+	Name     string
+	Detail   string
 	Selected bool
 }
 
-// Title returns the league name with selection indicator.
-func (l LeagueListItem) Title() string {
-	return l.League.Name
+// Title returns the team or conference name.
+func (l SettingsListItem) Title() string {
+	return l.Name
 }
 
-// Description returns the country.
-func (l LeagueListItem) Description() string {
-	return l.League.Country
+// Description returns the division and tricode, or what the filter does.
+func (l SettingsListItem) Description() string {
+	return l.Detail
 }
 
-// FilterValue returns the value used for filtering (league name + country).
-func (l LeagueListItem) FilterValue() string {
-	return l.League.Name + " " + l.League.Country
+// FilterValue returns the value used for filtering (name + detail).
+func (l SettingsListItem) FilterValue() string {
+	return l.Name + " " + l.Detail
 }
 
 // Title returns the match title for the list item.
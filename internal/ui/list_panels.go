@@ -6,6 +6,7 @@ import (
 
 	"github.com/gabriel7419/courtside/internal/api"
 	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/nba"
 	"github.com/gabriel7419/courtside/internal/ui/design"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
@@ -13,7 +14,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// GoalLinksMap maps goal keys (matchID:minute) to replay URLs.
+// GoalLinksMap maps play keys (matchID:period:clock) to highlight URLs.
 type GoalLinksMap map[string]string
 
 const (
@@ -23,16 +24,16 @@ const (
 )
 
 // MakeGoalLinkKey creates a key for the goal links map.
-func MakeGoalLinkKey(matchID, minute int) string {
-	return fmt.Sprintf("%d:%d", matchID, minute)
+func MakeGoalLinkKey(matchID, period int, clock string) string {
+	return fmt.Sprintf("%d:%d:%s", matchID, period, clock)
 }
 
-// GetReplayURL returns the replay URL for a goal if available.
-func (g GoalLinksMap) GetReplayURL(matchID, minute int) string {
+// GetReplayURL returns the highlight URL for a play if available.
+func (g GoalLinksMap) GetReplayURL(matchID, period int, clock string) string {
 	if g == nil {
 		return ""
 	}
-	return g[MakeGoalLinkKey(matchID, minute)]
+	return g[MakeGoalLinkKey(matchID, period, clock)]
 }
 
 // RenderLiveMatchesListPanel renders the left panel using bubbletea list component.
@@ -117,14 +118,27 @@ func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 		awayTeam = awayTeam[:maxTeamLen-1] + "…"
 	}
 
-	return fmt.Sprintf("  %s  %s vs %s",
+	cursor := "  "
+	if match.Selected {
+		cursor = neonValueStyle.Render("▸ ")
+	}
+	line := fmt.Sprintf("%s%s  %s vs %s",
+		cursor,
 		neonDimStyle.Render(timeStr),
 		neonValueStyle.Render(homeTeam),
 		neonValueStyle.Render(awayTeam))
+	if match.Favorite {
+		line += " " + favoriteStyle.Render(FavoriteMarker)
+	}
+	if match.Reminder {
+		line += " ⏰"
+	}
+	return line
 }
 
 // RenderStatsListPanel renders the left panel for stats view.
-func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange int, rightPanelFocused bool) string {
+// totalDays is the widest date range, the days of data loaded.
+func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange int, totalDays int, rightPanelFocused bool) string {
 	var header string
 	if rightPanelFocused {
 		header = design.RenderHeaderDim(constants.PanelMatchList, width-6)
@@ -132,7 +146,7 @@ func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange
 		header = design.RenderHeader(constants.PanelMatchList, width-6)
 	}
 
-	dateSelector := renderDateRangeSelector(width-6, dateRange)
+	dateSelector := renderDateRangeSelector(width-6, dateRange, totalDays)
 	emptyStyle := neonEmptyStyle.Width(width - 6)
 
 	var finishedListView string
@@ -165,22 +179,31 @@ func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange
 	return panel
 }
 
-func renderDateRangeSelector(width int, selected int) string {
-	options := []struct {
-		days  int
-		label string
-	}{
-		{1, "Today"},
-		{3, "3d"},
-		{5, "5d"},
+// StatsDateRanges returns the date ranges of the stats view, in days:
+// today, 3 days and every day loaded (1, 3, 5 by default).
+func StatsDateRanges(totalDays int) []int {
+	ranges := []int{1}
+	if totalDays > 3 {
+		ranges = append(ranges, 3)
+	}
+	if totalDays > 1 {
+		ranges = append(ranges, totalDays)
 	}
+	return ranges
+}
 
-	items := make([]string, 0, len(options))
-	for _, opt := range options {
-		if opt.days == selected {
-			items = append(items, neonDateSelectedStyle.Render(opt.label))
+func renderDateRangeSelector(width int, selected int, totalDays int) string {
+	ranges := StatsDateRanges(totalDays)
+	items := make([]string, 0, len(ranges))
+	for _, days := range ranges {
+		label := "Today"
+		if days > 1 {
+			label = fmt.Sprintf("%dd", days)
+		}
+		if days == selected {
+			items = append(items, neonDateSelectedStyle.Render(label))
 		} else {
-			items = append(items, neonDateUnselectedStyle.Render(opt.label))
+			items = append(items, neonDateUnselectedStyle.Render(label))
 		}
 	}
 
@@ -189,7 +212,8 @@ func renderDateRangeSelector(width int, selected int) string {
 }
 
 // RenderMultiPanelViewWithList renders the live matches view with list component.
-func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
+// comments adds the game thread comments panel on the right when not nil.
+func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, threads *ThreadLinks, comments *CommentsPanel, catchUp *nba.CatchUp, bannerType constants.StatusBannerType) string {
 	if width <= 0 {
 		width = 80
 	}
@@ -229,22 +253,33 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 		leftWidth = width - rightWidth - 1
 	}
 
+	// The comments panel takes its share of the details panel's width
+	commentsWidth := 0
+	if comments != nil {
+		commentsWidth = max(rightWidth*40/100, 30)
+		rightWidth -= commentsWidth + 1
+	}
+
 	panelHeight := availableHeight - 2
 
 	leftPanel := RenderLiveMatchesListPanel(leftWidth, panelHeight, listModel, upcomingMatches)
-	rightPanel := renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, liveUpdates, sp, loading, pollingSpinner, isPolling, goalLinks)
+	rightPanel := renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, liveUpdates, sp, loading, pollingSpinner, isPolling, goalLinks, threads, catchUp)
 
 	separatorStyle := neonSeparatorStyle.Height(panelHeight)
 	separator := separatorStyle.Render("┃")
 
 	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
+	if comments != nil {
+		commentsPanel := RenderCommentsPanel(commentsWidth, panelHeight, *comments)
+		panels = lipgloss.JoinHorizontal(lipgloss.Top, panels, separator, commentsPanel)
+	}
 	statusBanner := renderStatusBanner(bannerType, width)
 
 	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
 }
 
 // RenderStatsViewWithList renders the stats view with list component.
-func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int) string {
+func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, threads *ThreadLinks, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int) string {
 	if width <= 0 {
 		width = 80
 	}
@@ -286,17 +321,31 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 
 	panelHeight := availableHeight - 2
 
-	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange, rightPanelFocused)
-	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, rightPanelFocused)
+	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange, totalDays, rightPanelFocused)
+	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, threads, rightPanelFocused)
+
+	rightPanel := renderScrollableDetailsPanel(rightWidth, panelHeight, headerContent, scrollableContent, rightPanelFocused, scrollOffset)
+
+	separatorStyle := neonSeparatorStyle.Height(panelHeight)
+	separator := separatorStyle.Render("┃")
+
+	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
+	statusBanner := renderStatusBanner(bannerType, width)
 
-	var rightPanel string
+	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
+}
+
+// renderScrollableDetailsPanel lays out a details header above a scrollable body.
+// When focused, scrollOffset selects the visible window of the body; the focus
+// state also drives the border color and the help hint at the bottom.
+func renderScrollableDetailsPanel(rightWidth, panelHeight int, headerContent, scrollableContent string, rightPanelFocused bool, scrollOffset int) string {
 	scrollableLines := strings.Split(scrollableContent, "\n")
 	headerHeight := strings.Count(headerContent, "\n") + 1
-	availableHeight = max(panelHeight-headerHeight, minScrollableArea)
+	availableHeight := max(panelHeight-headerHeight, minScrollableArea)
 
 	visibleLines := scrollableLines
 	if rightPanelFocused && len(scrollableLines) > availableHeight {
-		start := scrollOffset
+		start := min(scrollOffset, len(scrollableLines)-availableHeight)
 		end := min(start+availableHeight, len(scrollableLines))
 		if start < len(scrollableLines) && start >= 0 {
 			visibleLines = scrollableLines[start:end]
@@ -324,39 +373,24 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 	helpStyle := neonDimStyle.Width(rightWidth - 4).Align(lipgloss.Center).MarginTop(1)
 	helpRendered := helpStyle.Render(helpText)
 
-	rightPanel = lipgloss.JoinVertical(lipgloss.Left, headerContent, visibleContent, helpRendered)
+	rightPanel := lipgloss.JoinVertical(lipgloss.Left, headerContent, visibleContent, helpRendered)
 
+	borderColor := neonDim
 	if rightPanelFocused {
-		rightPanel = lipgloss.NewStyle().
-			BorderTop(true).
-			BorderBottom(true).
-			BorderForeground(neonCyan).
-			Padding(0, 1).
-			Width(rightWidth).
-			MaxHeight(panelHeight).
-			Render(rightPanel)
-	} else {
-		rightPanel = lipgloss.NewStyle().
-			BorderTop(true).
-			BorderBottom(true).
-			BorderForeground(neonDim).
-			Padding(0, 1).
-			Width(rightWidth).
-			MaxHeight(panelHeight).
-			Render(rightPanel)
+		borderColor = neonCyan
 	}
-
-	separatorStyle := neonSeparatorStyle.Height(panelHeight)
-	separator := separatorStyle.Render("┃")
-
-	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
-	statusBanner := renderStatusBanner(bannerType, width)
-
-	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
+	return lipgloss.NewStyle().
+		BorderTop(true).
+		BorderBottom(true).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Width(rightWidth).
+		MaxHeight(panelHeight).
+		Render(rightPanel)
 }
 
 // renderStatsMatchDetailsPanel renders match details using unified rendering.
-func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, focused bool) (string, string) {
+func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, threads *ThreadLinks, focused bool) (string, string) {
 	if details == nil {
 		emptyMessage := neonDimStyle.
 			Align(lipgloss.Center).
@@ -378,6 +412,7 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 		Height:         height,
 		Details:        details,
 		GoalLinks:      goalLinks,
+		Threads:        threads,
 		ShowStatistics: true,
 		ShowHighlights: true,
 		Focused:        focused,
@@ -388,7 +423,7 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 
 // RenderMatchDetailsPanel is an exported version for debug scripts.
 func RenderMatchDetailsPanel(width, height int, details *api.MatchDetails) string {
-	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, false)
+	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, nil, false)
 	content := lipgloss.JoinVertical(lipgloss.Left, header, scrollable)
 	return neonPanelCyanStyle.
 		Width(width).
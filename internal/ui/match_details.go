@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/gabriel7419/courtside/internal/api"
 	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/nba"
 	"github.com/gabriel7419/courtside/internal/ui/design"
 )
 
@@ -17,6 +18,7 @@ type MatchDetailsConfig struct {
 	Width, Height int
 	Details       *api.MatchDetails
 	GoalLinks     GoalLinksMap
+	Threads       *ThreadLinks // r/nba threads of the game (nil if none found)
 
 	// View-specific features
 	ShowStatistics bool // Stats view only
@@ -24,6 +26,7 @@ type MatchDetailsConfig struct {
 
 	// Live view state
 	LiveUpdates    []string
+	CatchUp        *nba.CatchUp // What happened since the game was last seen (nil if nothing)
 	PollingSpinner *RandomCharSpinner
 	IsPolling      bool
 	Loading        bool
@@ -32,6 +35,12 @@ type MatchDetailsConfig struct {
 	Focused bool
 }
 
+// ThreadLinks are the URLs of a game's r/nba discussion threads; either may be empty.
+type ThreadLinks struct {
+	GameThread     string
+	PostGameThread string
+}
+
 // RenderMatchDetails renders match details content, returning header and scrollable content separately.
 // This unified function is used by both live and stats views.
 func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableContent string) {
@@ -88,12 +97,19 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 
 	// Match context (detailed info)
 	headerLines = append(headerLines, renderMatchContext(details, contentWidth)...)
+	if threads := renderThreadLinks(cfg.Threads); threads != "" {
+		headerLines = append(headerLines, threads)
+	}
 
 	// Penalties (prominent section)
 	if details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
 		headerLines = append(headerLines, renderPenaltiesSection(details, contentWidth)...)
 	}
 
+	if cfg.CatchUp != nil {
+		scrollableLines = append(scrollableLines, renderCatchUpCard(cfg.CatchUp, details, contentWidth), "")
+	}
+
 	// For live matches, show live updates instead of event details
 	if details.Status == api.MatchStatusLive || details.Status == api.MatchStatusNotStarted {
 		liveSection := renderLiveUpdatesSection(cfg, contentWidth)
@@ -175,6 +191,26 @@ func renderStatusLine(details *api.MatchDetails, contentWidth int) string {
 		Render(statusText + " • " + leagueText)
 }
 
+// renderThreadLinks renders the game's r/nba threads as terminal hyperlinks.
+func renderThreadLinks(threads *ThreadLinks) string {
+	if threads == nil {
+		return ""
+	}
+	var links []string
+	if threads.GameThread != "" {
+		links = append(links, Hyperlink(neonValueStyle.Render(constants.LinkGameThread), threads.GameThread))
+	}
+	if threads.PostGameThread != "" {
+		links = append(links, Hyperlink(neonValueStyle.Render(constants.LinkPostGameThread), threads.PostGameThread))
+	}
+	if len(links) == 0 {
+		return ""
+	}
+	return neonLabelStyle.Render("r/nba:       ") +
+		strings.Join(links, neonDimStyle.Render(" · ")) + "  " +
+		neonDimStyle.Render(constants.HelpThreadLinks)
+}
+
 func renderMatchContext(details *api.MatchDetails, contentWidth int) []string {
 	var lines []string
 
@@ -278,7 +314,7 @@ func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 		isHome := goal.Team.ID == details.HomeTeam.ID
 
 		playerDetails := neonValueStyle.Render(player)
-		replayIndicator := getReplayIndicator(details, cfg.GoalLinks, goal.Minute)
+		replayIndicator := getReplayIndicator(details, cfg.GoalLinks, goal.DisplayMinute)
 
 		// Build label based on event type
 		var label string
@@ -451,6 +487,12 @@ func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam,
 func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	var lines []string
 
+	if cfg.Details != nil && cfg.Details.Status == api.MatchStatusLive {
+		if watch := renderMilestoneWatch(cfg, contentWidth); watch != "" {
+			lines = append(lines, watch, "")
+		}
+	}
+
 	var titleText string
 	if cfg.IsPolling && cfg.Loading && cfg.PollingSpinner != nil {
 		pollingView := cfg.PollingSpinner.View()
@@ -486,6 +528,74 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderMilestoneWatch lists the players one play away from a milestone,
+// such as "J. Tatum  BOS  2 pts for 30 points · 1 reb for a double-double".
+func renderMilestoneWatch(cfg MatchDetailsConfig, contentWidth int) string {
+	watch := nba.WatchMilestones(cfg.Details)
+	if len(watch) == 0 {
+		return ""
+	}
+
+	title := lipgloss.NewStyle().
+		Foreground(neonCyan).
+		Bold(true).
+		BorderBottom(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(neonDarkDim).
+		Width(cfg.Width - 6).
+		Render(constants.PanelMilestoneWatch)
+	lines := []string{title}
+
+	starStyle := lipgloss.NewStyle().Foreground(neonYellow)
+	for _, w := range watch {
+		line := fmt.Sprintf("%s %s  %s  %s",
+			starStyle.Render("☆"),
+			neonValueStyle.Render(w.Player),
+			neonDimStyle.Render(w.Team.ShortName),
+			strings.Join(w.Needs, " · "))
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(contentWidth).Render(line))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderCatchUpCard renders the "since you left" card: the score then and now,
+// runs, lead changes, notable plays and foul trouble.
+func renderCatchUpCard(c *nba.CatchUp, details *api.MatchDetails, contentWidth int) string {
+	home, away := details.HomeTeam.ShortName, details.AwayTeam.ShortName
+	title := lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render(constants.PanelCatchUp) +
+		neonDimStyle.Render(fmt.Sprintf(" · %d plays", c.Events))
+
+	score := func(h, a int) string {
+		return fmt.Sprintf("%s %d - %d %s", home, h, a, away)
+	}
+	lines := []string{
+		title,
+		neonDimStyle.Render(score(c.HomeBefore, c.AwayBefore)) + "  →  " + neonValueStyle.Bold(true).Render(score(c.HomeNow, c.AwayNow)),
+	}
+
+	label := func(s string) string { return neonLabelStyle.Render(s) }
+	if len(c.Runs) > 0 {
+		lines = append(lines, label("Runs: ")+neonValueStyle.Render(strings.Join(c.Runs, ", ")))
+	}
+	if c.LeadChanges > 0 {
+		lines = append(lines, label("Lead changes: ")+neonValueStyle.Render(strconv.Itoa(c.LeadChanges)))
+	}
+	if len(c.FoulTrouble) > 0 {
+		lines = append(lines, label("Foul trouble: ")+lipgloss.NewStyle().Foreground(neonYellow).Render(strings.Join(c.FoulTrouble, ", ")))
+	}
+	for _, play := range c.Plays {
+		lines = append(lines, neonDimStyle.Render("  • ")+neonValueStyle.Render(play))
+	}
+	lines = append(lines, neonDimStyle.Render(constants.HelpCatchUp))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(neonCyan).
+		Padding(0, 1).
+		Width(contentWidth - 2).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
 // Statistics rendering functions
 
 const statBarWidth = 20
@@ -4,12 +4,22 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/gabriel7419/courtside/internal/api"
 )
 
+// FavoriteMarker marks favorite teams and their games.
+const FavoriteMarker = "♥"
+
+// favoriteStyle colors the favorite marker and favorite teams' standings rows.
+var favoriteStyle = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+
 // MatchDisplay wraps a match with display information for rendering.
 type MatchDisplay struct {
 	api.Match
+	Selected bool // Highlighted in the upcoming games list
+	Reminder bool // A tip-off reminder is set
+	Favorite bool // A favorite team plays
 }
 
 // Title returns a formatted title for the match.
@@ -22,6 +32,9 @@ func (m MatchDisplay) Title() string {
 	if away == "" {
 		away = m.AwayTeam.Name
 	}
+	if m.Favorite {
+		return FavoriteMarker + " " + home + " vs " + away
+	}
 	return home + " vs " + away
 }
 
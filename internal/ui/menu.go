@@ -43,6 +43,8 @@ func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner
 	menuItems := []string{
 		constants.MenuStats,
 		constants.MenuLiveMatches,
+		constants.MenuSchedule,
+		constants.MenuLeaders,
 		constants.MenuSettings,
 	}
 
@@ -2,22 +2,27 @@ package ui
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/gabriel7419/courtside/internal/api"
 	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/nba"
 	"github.com/gabriel7419/courtside/internal/ui/design"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// getReplayIndicator returns the replay link indicator for a goal if available.
-func getReplayIndicator(details *api.MatchDetails, goalLinks GoalLinksMap, minute int) string {
+// getReplayIndicator returns the replay link indicator for the play at a
+// display time ("Q3 4:52") if available.
+func getReplayIndicator(details *api.MatchDetails, goalLinks GoalLinksMap, displayMinute string) string {
 	if details == nil || goalLinks == nil {
 		return ""
 	}
-	replayURL := goalLinks.GetReplayURL(details.ID, minute)
+	period, clock, ok := nba.ParseGameClock(displayMinute)
+	if !ok {
+		return ""
+	}
+	replayURL := goalLinks.GetReplayURL(details.ID, period, clock)
 	if IsValidReplayURL(replayURL) {
 		return CreateGoalLinkDisplay("", replayURL)
 	}
@@ -73,12 +78,12 @@ func renderCenterAlignedEvent(minuteStr string, eventContent string, isHomeTeam
 }
 
 // renderMatchDetailsPanelWithPolling renders the right panel with polling spinner support.
-func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
-	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks)
+func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap, threads *ThreadLinks, catchUp *nba.CatchUp) string {
+	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks, threads, catchUp)
 }
 
 // renderMatchDetailsPanelFull renders the right panel with match details using unified rendering.
-func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap, threads *ThreadLinks, catchUp *nba.CatchUp) string {
 	detailsPanelStyle := lipgloss.NewStyle().Padding(0, 1)
 
 	if details == nil {
@@ -108,9 +113,11 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		Height:         height,
 		Details:        details,
 		GoalLinks:      goalLinks,
+		Threads:        threads,
 		ShowStatistics: false,
 		ShowHighlights: false,
 		LiveUpdates:    liveUpdates,
+		CatchUp:        catchUp,
 		PollingSpinner: pollingSpinner,
 		IsPolling:      isPolling,
 		Loading:        loading,
@@ -145,8 +152,15 @@ func extractTeamMarker(update string) (string, bool) {
 	return update, true
 }
 
-// extractMinuteFromUpdate extracts the minute string from a live update.
+// extractMinuteFromUpdate extracts the minute string from a live update,
+// or the period and clock of a basketball play ("● Q3 4:52 [3PT] ...").
 func extractMinuteFromUpdate(update string) (minute string, rest string) {
+	if fields := strings.SplitN(update, " ", 4); len(fields) == 4 {
+		if _, _, ok := nba.ParseGameClock(fields[1] + " " + fields[2]); ok {
+			return fields[1] + " " + fields[2], fields[0] + " " + fields[3]
+		}
+	}
+
 	parts := strings.SplitN(update, "' ", 2)
 	if len(parts) != 2 {
 		return "", update
@@ -184,24 +198,20 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 
 	var styledContent string
 	switch symbol {
-	case "●": // Goal - gradient
-		// Check for own goal marker in the update string
+	case "●": // Score - gradient
+		// Label from the marker in the update string: a basket, three or free throw
 		label := "GOAL"
-		if strings.Contains(contentWithoutMinute, "[OWN GOAL]") {
-			label = "OWN GOAL"
+		for _, l := range []string{"OWN GOAL", "3PT", "BASKET", "FT"} {
+			if strings.Contains(contentWithoutMinute, "["+l+"]") {
+				label = l
+				break
+			}
 		}
 		marker := fmt.Sprintf("[%s]", label)
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, marker)
 		styledType := design.ApplyGradientToText(label)
 		styledPlayer := whiteStyle.Render(playerDetails)
-
-		replayIndicator := ""
-		if details != nil && goalLinks != nil {
-			minuteStr := strings.TrimSuffix(minute, "'")
-			if minuteInt, err := strconv.Atoi(minuteStr); err == nil {
-				replayIndicator = getReplayIndicator(details, goalLinks, minuteInt)
-			}
-		}
+		replayIndicator := getReplayIndicator(details, goalLinks, minute)
 
 		styledContent = buildEventContent(styledPlayer, replayIndicator, symbol, styledType, isHome)
 	case "▪": // Yellow card
@@ -212,10 +222,20 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 		cardStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
 		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
+	case "★": // Player milestone - highlighted
+		milestoneStyle := lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
+		playerDetails, _ := extractPlayerAndType(strings.Replace(contentWithoutMinute, " [MILESTONE]", "", 1), "")
+		styledContent = buildEventContent(whiteStyle.Bold(true).Render(playerDetails), "", milestoneStyle.Render(symbol), milestoneStyle.Render("MILESTONE"), isHome)
 	case "↔": // Substitution
 		styledContent = renderSubstitutionWithColorsNoMinute(contentWithoutMinute, isHome)
-	case "·": // Other
+	case "·": // Other; blocks are labeled and can have a clip
 		dimStyle := lipgloss.NewStyle().Foreground(neonDim)
+		if strings.Contains(contentWithoutMinute, "[BLOCK]") {
+			playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[BLOCK]")
+			replayIndicator := getReplayIndicator(details, goalLinks, minute)
+			styledContent = buildEventContent(whiteStyle.Render(playerDetails), replayIndicator, symbol, dimStyle.Render("BLOCK"), isHome)
+			break
+		}
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "")
 		styledContent = buildEventContent(dimStyle.Render(playerDetails), "", symbol, "", isHome)
 	default:
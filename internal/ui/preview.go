@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+)
+
+// renderGamePreview renders the pre-game comparison below the tip-off card:
+// records and seeds, streaks, recent form, season series, projected starters
+// and top scorers, with the home team on the left.
+func renderGamePreview(preview *api.GamePreview, width int) []string {
+	colWidth := max((width-2)/2, 14)
+	row := func(home, away string) string {
+		cell := lipgloss.NewStyle().Width(colWidth).MaxWidth(colWidth)
+		return cell.Render(home) + "  " + cell.Render(away)
+	}
+	section := func(title string) []string {
+		return []string{"", neonHeaderStyle.Render(title), neonDimStyle.Render(strings.Repeat("─", width))}
+	}
+
+	home, away := preview.Home, preview.Away
+	lines := []string{row(neonTeamStyle.Render(previewTeamName(home.Team)), neonTeamStyle.Render(previewTeamName(away.Team)))}
+
+	lines = append(lines, section(constants.PreviewStandings)...)
+	lines = append(lines, row(neonValueStyle.Render(fmt.Sprintf("%d-%d", home.Wins, home.Losses)), neonValueStyle.Render(fmt.Sprintf("%d-%d", away.Wins, away.Losses))))
+	lines = append(lines, row(neonDimStyle.Render(previewSeed(home)), neonDimStyle.Render(previewSeed(away))))
+	lines = append(lines, row(previewStreak(home.Streak), previewStreak(away.Streak)))
+
+	lines = append(lines, section(constants.PreviewLastFive)...)
+	lines = append(lines, row(previewForm(home), previewForm(away)))
+
+	lines = append(lines, section(constants.PreviewSeasonSeries)...)
+	lines = append(lines, renderSeasonSeries(preview, width)...)
+
+	if len(home.Starters) > 0 || len(away.Starters) > 0 {
+		lines = append(lines, section(constants.PreviewStarters)...)
+		for i := 0; i < max(len(home.Starters), len(away.Starters)); i++ {
+			lines = append(lines, row(neonValueStyle.Render(previewAt(home.Starters, i)), neonValueStyle.Render(previewAt(away.Starters, i))))
+		}
+	}
+
+	lines = append(lines, section(constants.PreviewTopScorers)...)
+	if len(home.TopScorers) == 0 && len(away.TopScorers) == 0 {
+		lines = append(lines, neonDimStyle.Render(constants.EmptyNoTeamAverages))
+	}
+	for i := 0; i < max(len(home.TopScorers), len(away.TopScorers)); i++ {
+		lines = append(lines, row(previewScorer(home.TopScorers, i), previewScorer(away.TopScorers, i)))
+	}
+	return lines
+}
+
+// renderSeasonSeries renders the series score line and one row per meeting.
+func renderSeasonSeries(preview *api.GamePreview, width int) []string {
+	if len(preview.SeasonSeries) == 0 {
+		return []string{neonDimStyle.Render(constants.EmptyNoSeasonSeries)}
+	}
+
+	homeID := preview.Home.Team.ID
+	homeWins, awayWins := 0, 0
+	var rows []string
+	for _, m := range preview.SeasonSeries {
+		date := "—"
+		if m.MatchTime != nil {
+			date = m.MatchTime.Local().Format("Jan 02")
+		}
+		matchup := fmt.Sprintf("%s @ %s", previewTeamName(m.AwayTeam), previewTeamName(m.HomeTeam))
+		result := neonDimStyle.Render("upcoming")
+		switch {
+		case m.ID == preview.MatchID:
+			result = neonHeaderStyle.Render("this game")
+		case m.Status == api.MatchStatusFinished && m.HomeScore != nil && m.AwayScore != nil:
+			result = neonValueStyle.Render(fmt.Sprintf("%d-%d", *m.AwayScore, *m.HomeScore))
+			if teamWon(m, homeID) {
+				homeWins++
+			} else {
+				awayWins++
+			}
+		}
+		rows = append(rows, lipgloss.NewStyle().MaxWidth(width).Render(
+			neonDimStyle.Render(fmt.Sprintf("%-8s", date))+neonValueStyle.Render(fmt.Sprintf("%-13s", matchup))+result))
+	}
+
+	var summary string
+	switch {
+	case homeWins == 0 && awayWins == 0:
+		summary = "First meeting this season"
+	case homeWins > awayWins:
+		summary = fmt.Sprintf("%s leads %d-%d", previewTeamName(preview.Home.Team), homeWins, awayWins)
+	case awayWins > homeWins:
+		summary = fmt.Sprintf("%s leads %d-%d", previewTeamName(preview.Away.Team), awayWins, homeWins)
+	default:
+		summary = fmt.Sprintf("Series tied %d-%d", homeWins, awayWins)
+	}
+	return append([]string{neonTeamStyle.Render(summary)}, rows...)
+}
+
+// previewTeamName prefers the tricode.
+func previewTeamName(t api.Team) string {
+	if t.ShortName != "" {
+		return t.ShortName
+	}
+	return t.Name
+}
+
+// previewSeed renders "4th in East", or "" when standings are unavailable.
+func previewSeed(t api.PreviewTeam) string {
+	if t.ConferenceRank == 0 {
+		return ""
+	}
+	if t.Conference == "" {
+		return ordinal(t.ConferenceRank)
+	}
+	return fmt.Sprintf("%s in %s", ordinal(t.ConferenceRank), t.Conference)
+}
+
+// previewStreak highlights a winning streak such as "W3" and dims a losing one,
+// like results in the team dialog.
+func previewStreak(streak string) string {
+	switch {
+	case strings.HasPrefix(streak, "W"):
+		return neonHeaderStyle.Render(streak)
+	case strings.HasPrefix(streak, "L"):
+		return neonDimStyle.Render(streak)
+	}
+	return neonDimStyle.Render("—")
+}
+
+// previewForm renders the last five results as "W W L W L", most recent first.
+func previewForm(t api.PreviewTeam) string {
+	if len(t.LastFive) == 0 {
+		return neonDimStyle.Render(constants.EmptyNoRecentResults)
+	}
+	results := make([]string, 0, len(t.LastFive))
+	for _, m := range t.LastFive {
+		if teamWon(m, t.Team.ID) {
+			results = append(results, previewStreak("W"))
+		} else {
+			results = append(results, previewStreak("L"))
+		}
+	}
+	return strings.Join(results, " ")
+}
+
+// previewScorer renders "J. Tatum 27.1/8.4/4.9" for row i, or "" past the end.
+func previewScorer(scorers []api.PreviewScorer, i int) string {
+	if i >= len(scorers) {
+		return ""
+	}
+	s := scorers[i]
+	return neonValueStyle.Render(s.Name) + " " + neonDimStyle.Render(fmt.Sprintf("%.1f/%.1f/%.1f", s.Points, s.Rebounds, s.Assists))
+}
+
+// previewAt returns items[i], or "" past the end.
+func previewAt(items []string, i int) string {
+	if i >= len(items) {
+		return ""
+	}
+	return items[i]
+}
@@ -0,0 +1,268 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gabriel7419/courtside/internal/api"
+	"github.com/gabriel7419/courtside/internal/constants"
+	"github.com/gabriel7419/courtside/internal/ui/design"
+)
+
+// ScheduleViewConfig holds all parameters for rendering the schedule view.
+type ScheduleViewConfig struct {
+	Width, Height int
+	List          list.Model
+	Date          time.Time         // Day currently shown
+	Selected      *api.Match        // Game highlighted in the list (nil if none)
+	Details       *api.MatchDetails // Loaded details for started/finished games
+	Preview       *api.GamePreview  // Pre-game preview for a scheduled game (nil while loading)
+	LiveUpdates   []string          // Parsed play-by-play when the selected game is live
+	GoalLinks     GoalLinksMap
+	Threads       *ThreadLinks
+
+	// Date input (jump-to-date)
+	DateInput       string // Rendered text input view
+	DateInputActive bool
+
+	Spinner       *RandomCharSpinner
+	Loading       bool
+	BannerType    constants.StatusBannerType
+	RightFocused  bool
+	ScrollOffset  int
+	DayIsLoading  bool   // Games for Date are still being fetched
+	DateInputHint string // Optional validation message shown under the input
+	Reminder      int    // Lead time in minutes of the selected game's reminder (0 if none)
+}
+
+// RenderScheduleView renders the schedule view: a day navigator with the games
+// of that day on the left and the selected game on the right.
+// Scheduled games show a tip-off card; started and finished games reuse the
+// unified match details layout.
+func RenderScheduleView(cfg ScheduleViewConfig) string {
+	width, height := cfg.Width, cfg.Height
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	spinnerHeight := 3
+	availableHeight := max(height-spinnerHeight, minPanelHeight)
+
+	spinnerStyle := lipgloss.NewStyle().
+		Width(width).
+		Height(spinnerHeight).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	var spinnerArea string
+	if cfg.Loading && cfg.Spinner != nil {
+		if spinnerView := cfg.Spinner.View(); spinnerView != "" {
+			spinnerArea = spinnerStyle.Render(spinnerView)
+		} else {
+			spinnerArea = spinnerStyle.Render("Loading...")
+		}
+	} else {
+		spinnerArea = spinnerStyle.Render("")
+	}
+
+	leftWidth := max(width*35/100, 25)
+	rightWidth := width - leftWidth - 1
+	if rightWidth < 35 {
+		rightWidth = 35
+		leftWidth = width - rightWidth - 1
+	}
+
+	panelHeight := availableHeight - 2
+
+	leftPanel := RenderScheduleListPanel(leftWidth, panelHeight, cfg)
+
+	var headerContent, scrollableContent string
+	switch {
+	case cfg.Selected != nil && cfg.Selected.Status == api.MatchStatusNotStarted:
+		headerContent, scrollableContent = renderScheduledGame(rightWidth, *cfg.Selected, cfg.Preview, cfg.Reminder, cfg.RightFocused)
+	case cfg.Details != nil:
+		headerContent, scrollableContent = RenderMatchDetails(MatchDetailsConfig{
+			Width:          rightWidth,
+			Height:         panelHeight,
+			Details:        cfg.Details,
+			GoalLinks:      cfg.GoalLinks,
+			Threads:        cfg.Threads,
+			ShowStatistics: true,
+			ShowHighlights: true,
+			LiveUpdates:    cfg.LiveUpdates,
+			Focused:        cfg.RightFocused,
+		})
+	default:
+		_, scrollableContent = renderStatsMatchDetailsPanel(rightWidth, panelHeight, nil, nil, nil, false)
+	}
+
+	rightPanel := renderScrollableDetailsPanel(rightWidth, panelHeight, headerContent, scrollableContent, cfg.RightFocused, cfg.ScrollOffset)
+
+	separator := neonSeparatorStyle.Height(panelHeight).Render("┃")
+	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
+	statusBanner := renderStatusBanner(cfg.BannerType, width)
+
+	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
+}
+
+// RenderScheduleListPanel renders the left panel of the schedule view.
+func RenderScheduleListPanel(width, height int, cfg ScheduleViewConfig) string {
+	contentWidth := width - 6
+
+	var header string
+	if cfg.RightFocused {
+		header = design.RenderHeaderDim(constants.PanelSchedule, contentWidth)
+	} else {
+		header = design.RenderHeader(constants.PanelSchedule, contentWidth)
+	}
+
+	navigator := renderDayNavigator(contentWidth, cfg.Date)
+
+	lines := []string{header, "", navigator}
+	if cfg.DateInputActive {
+		lines = append(lines, "", cfg.DateInput)
+		hint := constants.HelpScheduleDateInput
+		if cfg.DateInputHint != "" {
+			hint = cfg.DateInputHint
+		}
+		lines = append(lines, neonDimStyle.Width(contentWidth).Render(hint))
+	} else {
+		lines = append(lines, neonDimStyle.Width(contentWidth).Align(lipgloss.Center).Render(constants.HelpScheduleView))
+	}
+	lines = append(lines, "")
+
+	emptyStyle := neonEmptyStyle.Width(contentWidth)
+	switch {
+	case cfg.DayIsLoading:
+		lines = append(lines, emptyStyle.Render(constants.LoadingFetching))
+	case len(cfg.List.Items()) == 0:
+		lines = append(lines, emptyStyle.Render(constants.EmptyNoScheduledGames))
+	default:
+		lines = append(lines, cfg.List.View())
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	if innerHeight := height - 2; innerHeight > 0 {
+		content = truncateToHeight(content, innerHeight)
+	}
+
+	if cfg.RightFocused {
+		return lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(neonDim).
+			Padding(0, 1).
+			Width(width).
+			Height(height).
+			Render(content)
+	}
+	return neonPanelStyle.Width(width).Height(height).Render(content)
+}
+
+// renderDayNavigator renders "‹  Tue 21 Oct · Tomorrow  ›" for the given day.
+func renderDayNavigator(width int, date time.Time) string {
+	label := date.Format("Mon 02 Jan 2006")
+	if rel := relativeDayLabel(date, time.Now()); rel != "" {
+		label += " · " + rel
+	}
+	text := neonDimStyle.Render("‹  ") + neonDateSelectedStyle.Render(label) + neonDimStyle.Render("  ›")
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(text)
+}
+
+// relativeDayLabel returns "Today", "Tomorrow" or "Yesterday" when applicable.
+func relativeDayLabel(date, now time.Time) string {
+	day := date.Local().Format("2006-01-02")
+	switch day {
+	case now.Local().Format("2006-01-02"):
+		return "Today"
+	case now.Local().AddDate(0, 0, 1).Format("2006-01-02"):
+		return "Tomorrow"
+	case now.Local().AddDate(0, 0, -1).Format("2006-01-02"):
+		return "Yesterday"
+	}
+	return ""
+}
+
+// renderScheduledGame renders the tip-off card for a game that has not started,
+// followed by the pre-game preview once it has loaded.
+func renderScheduledGame(width int, match api.Match, preview *api.GamePreview, reminder int, focused bool) (headerContent, scrollableContent string) {
+	contentWidth := width - 6
+
+	homeTeam := match.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = match.HomeTeam.Name
+	}
+	awayTeam := match.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = match.AwayTeam.Name
+	}
+
+	center := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center)
+
+	var headerLines []string
+	headerLines = append(headerLines, renderPanelHeader(constants.PanelMatchDetails, focused, contentWidth))
+	headerLines = append(headerLines, "")
+	headerLines = append(headerLines, center.Render(neonDimStyle.Render(constants.StatusNotStartedShort)+" • "+neonDimStyle.Italic(true).Render(match.League.Name)))
+	headerLines = append(headerLines, "")
+	headerLines = append(headerLines, center.Render(fmt.Sprintf("%s  vs  %s", neonTeamStyle.Render(homeTeam), neonTeamStyle.Render(awayTeam))))
+	headerLines = append(headerLines, "")
+
+	tipOff := "TBD"
+	if match.MatchTime != nil {
+		tipOff = match.MatchTime.Local().Format("15:04")
+	}
+	headerLines = append(headerLines, center.Render(lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render(tipOff)))
+	if match.MatchTime != nil {
+		if until := formatTimeUntil(time.Until(*match.MatchTime)); until != "" {
+			headerLines = append(headerLines, center.Render(neonDimStyle.Render(until)))
+		}
+	}
+	headerLines = append(headerLines, "")
+
+	var lines []string
+	if match.MatchTime != nil {
+		lines = append(lines, neonLabelStyle.Render(constants.LabelTipOff)+neonValueStyle.Render(match.MatchTime.Local().Format("Mon 02 Jan 2006, 15:04 MST")))
+	}
+	tv := "—"
+	if len(match.Broadcasters) > 0 {
+		tv = strings.Join(match.Broadcasters, ", ")
+	}
+	lines = append(lines, neonLabelStyle.Render(constants.LabelTV)+neonValueStyle.Render(truncateString(tv, contentWidth-14)))
+	if match.SeriesStatus != nil && *match.SeriesStatus != "" {
+		lines = append(lines, neonLabelStyle.Render("Series: ")+neonValueStyle.Render(*match.SeriesStatus))
+	}
+	if reminder > 0 {
+		lines = append(lines, neonLabelStyle.Render(constants.LabelReminder)+neonValueStyle.Render(fmt.Sprintf("⏰ %d min before tip-off", reminder)))
+	}
+	if preview != nil && preview.MatchID == match.ID {
+		lines = append(lines, "")
+		lines = append(lines, renderGamePreview(preview, contentWidth)...)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, headerLines...),
+		lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// formatTimeUntil renders a short countdown such as "in 2d 4h" or "in 35m".
+// Returns "" for durations in the past.
+func formatTimeUntil(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("in %dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("in %dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("in %dm", max(minutes, 1))
+	}
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/gabriel7419/courtside/internal/constants"
 	"github.com/gabriel7419/courtside/internal/data"
@@ -15,53 +16,41 @@ import (
 
 // SettingsState holds the state for the settings view.
 type SettingsState struct {
-	List          list.Model        // List component for league navigation
-	Selected      map[int]bool      // Map of league ID -> selected
-	Leagues       []data.LeagueInfo // All leagues for current region
-	AllLeagues    []data.LeagueInfo // All leagues across all regions
-	Regions       []string          // Available regions
-	CurrentRegion int               // Index of current region
-	HasChanges    bool              // Whether there are unsaved changes
+	List        list.Model      // List component for team and filter navigation
+	Favorites   map[string]bool // Map of team tricode -> favorite
+	Conferences map[string]bool // Map of conference -> games listed
+	Tabs        []string        // Available tabs: one per conference, then the list filters
+	CurrentTab  int             // Index of current tab
+	HasChanges  bool            // Whether there are unsaved changes
+
+	settings *data.Settings // Loaded settings, saved back with the changes
+	loadErr  error          // Why settings.yaml couldn't be read; nothing is saved then
 }
 
 // NewSettingsState creates a new settings state with current saved preferences.
 func NewSettingsState() *SettingsState {
-	settings, _ := data.LoadSettings()
-
-	selected := make(map[int]bool)
-
-	// If no leagues are selected in settings, none are checked
-	// User sees unchecked = will use default leagues (Premier, La Liga, UCL)
-	if len(settings.SelectedLeagues) > 0 {
-		for _, id := range settings.SelectedLeagues {
-			selected[id] = true
-		}
+	// A settings.yaml that doesn't parse is shown as an error and left as it
+	// is, instead of being overwritten with the few settings edited here
+	settings, loadErr := data.ReadSettings()
+	if loadErr != nil {
+		settings = &data.Settings{}
 	}
 
-	regions := data.GetAllRegions()
-	currentRegion := 0 // Start with first region (Europe)
-
-	// Get all leagues for current region
-	leagues := data.GetLeaguesForRegion(regions[currentRegion])
-
-	// Get all leagues across all regions for saving/loading
-	allLeagueInfos := make([]data.LeagueInfo, 0, len(data.AllLeagueIDs()))
-	for _, region := range regions {
-		allLeagueInfos = append(allLeagueInfos, data.GetLeaguesForRegion(region)...)
+	favorites := make(map[string]bool)
+	for _, tricode := range settings.FavoriteTeams {
+		favorites[tricode] = true
 	}
 
-	// Create list items for current region
-	items := make([]list.Item, len(leagues))
-	for i, league := range leagues {
-		items[i] = LeagueListItem{
-			League:   league,
-			Selected: selected[league.ID],
-		}
+	// No conference filter means every game is listed, so all are checked
+	conferences := make(map[string]bool)
+	for _, conference := range data.GetAllConferences() {
+		conferences[conference] = len(settings.Conferences) == 0 ||
+			slices.Contains(settings.Conferences, conference)
 	}
 
 	// Create and configure the list
-	delegate := NewLeagueListDelegate()
-	l := list.New(items, delegate, 0, 0)
+	delegate := NewSettingsListDelegate()
+	l := list.New(nil, delegate, 0, 0)
 	l.SetShowTitle(false)
 	l.SetShowStatusBar(true)
 	l.SetFilteringEnabled(true)
@@ -75,108 +64,187 @@ func NewSettingsState() *SettingsState {
 	l.FilterInput.PromptStyle = filterPromptStyle
 	l.FilterInput.Cursor.Style = filterCursorStyle
 
-	return &SettingsState{
-		List:          l,
-		Selected:      selected,
-		Leagues:       leagues,
-		AllLeagues:    allLeagueInfos,
-		Regions:       regions,
-		CurrentRegion: currentRegion,
+	s := &SettingsState{
+		List:        l,
+		Favorites:   favorites,
+		Conferences: conferences,
+		Tabs:        []string{constants.SettingsTabEast, constants.SettingsTabWest, constants.SettingsTabLists},
+		settings:    settings,
+		loadErr:     loadErr,
+	}
+	s.refreshListItems()
+	return s
+}
+
+// tabConference returns the conference whose teams a tab lists, or "" for
+// the list filters tab.
+func tabConference(tab string) string {
+	switch tab {
+	case constants.SettingsTabEast:
+		return data.ConferenceEast
+	case constants.SettingsTabWest:
+		return data.ConferenceWest
 	}
+	return ""
 }
 
-// Toggle toggles the selection state of the currently highlighted league.
+// Toggle toggles the highlighted team's favorite or conference filter.
 func (s *SettingsState) Toggle() {
-	if item, ok := s.List.SelectedItem().(LeagueListItem); ok {
-		s.Selected[item.League.ID] = !s.Selected[item.League.ID]
-		s.HasChanges = true
-		s.refreshListItems()
+	item, ok := s.List.SelectedItem().(SettingsListItem)
+	if !ok {
+		return
 	}
+	if tabConference(s.Tabs[s.CurrentTab]) != "" {
+		s.Favorites[item.Key] = !s.Favorites[item.Key]
+	} else {
+		s.Conferences[item.Key] = !s.Conferences[item.Key]
+	}
+	s.HasChanges = true
+	s.refreshListItems()
 }
 
-// refreshListItems updates the list items to reflect current selection state for the current region.
+// refreshListItems updates the list items to reflect current selection state for the current tab.
 func (s *SettingsState) refreshListItems() {
-	items := make([]list.Item, len(s.Leagues))
-	for i, league := range s.Leagues {
-		items[i] = LeagueListItem{
-			League:   league,
-			Selected: s.Selected[league.ID],
+	var items []list.Item
+	if conference := tabConference(s.Tabs[s.CurrentTab]); conference != "" {
+		// Teams come by division, so each division's teams are listed together
+		for _, team := range data.GetTeamsForConference(conference) {
+			items = append(items, SettingsListItem{
+				Key:      team.Tricode,
+				Name:     team.Name,
+				Detail:   fmt.Sprintf("%s Division · %s", team.Division, team.Tricode),
+				Selected: s.Favorites[team.Tricode],
+			})
+		}
+	} else {
+		for _, conference := range data.GetAllConferences() {
+			items = append(items, SettingsListItem{
+				Key:      conference,
+				Name:     conference + "ern Conference",
+				Detail:   "List games with a team from the " + conference,
+				Selected: s.Conferences[conference],
+			})
 		}
 	}
 	s.List.SetItems(items)
 }
 
-// switchToRegion switches to a different region and updates the league list.
-func (s *SettingsState) switchToRegion(regionIndex int) {
-	if regionIndex < 0 || regionIndex >= len(s.Regions) {
+// switchToTab switches to a different tab and updates the list.
+func (s *SettingsState) switchToTab(tabIndex int) {
+	if tabIndex < 0 || tabIndex >= len(s.Tabs) {
 		return
 	}
 
-	s.CurrentRegion = regionIndex
-	s.Leagues = data.GetLeaguesForRegion(s.Regions[regionIndex])
+	s.CurrentTab = tabIndex
 	s.refreshListItems()
+	s.List.Select(0)
 
-	// Reset filter when switching regions
+	// Reset filter when switching tabs
 	s.List.ResetFilter()
 }
 
-// NextRegion switches to the next region (with wraparound).
-func (s *SettingsState) NextRegion() {
-	nextRegion := (s.CurrentRegion + 1) % len(s.Regions)
-	s.switchToRegion(nextRegion)
+// NextTab switches to the next tab (with wraparound).
+func (s *SettingsState) NextTab() {
+	s.switchToTab((s.CurrentTab + 1) % len(s.Tabs))
 }
 
-// PreviousRegion switches to the previous region (with wraparound).
-func (s *SettingsState) PreviousRegion() {
-	prevRegion := s.CurrentRegion - 1
-	if prevRegion < 0 {
-		prevRegion = len(s.Regions) - 1
-	}
-	s.switchToRegion(prevRegion)
+// PreviousTab switches to the previous tab (with wraparound).
+func (s *SettingsState) PreviousTab() {
+	s.switchToTab((s.CurrentTab + len(s.Tabs) - 1) % len(s.Tabs))
 }
 
-// Save persists the current selection to settings.yaml.
+// Save persists the favorite teams and conference filters to settings.yaml,
+// keeping the rest of the settings as they were. Nothing is written without
+// changes, or when settings.yaml couldn't be read.
 func (s *SettingsState) Save() error {
-	var selectedIDs []int
-	for _, league := range s.AllLeagues {
-		if s.Selected[league.ID] {
-			selectedIDs = append(selectedIDs, league.ID)
+	if s.loadErr != nil {
+		return fmt.Errorf("settings not saved: %w", s.loadErr)
+	}
+	if !s.HasChanges {
+		return nil
+	}
+	settings := *s.settings
+
+	// Favorites in league order; tricodes that aren't NBA teams are kept as they were
+	var favorites []string
+	for _, team := range data.NBATeams {
+		if s.Favorites[team.Tricode] {
+			favorites = append(favorites, team.Tricode)
 		}
 	}
+	for _, tricode := range s.settings.FavoriteTeams {
+		if _, ok := data.TeamByTricode(tricode); !ok {
+			favorites = append(favorites, tricode)
+		}
+	}
+	settings.FavoriteTeams = favorites
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
+	// Every conference or none checked both mean no filter
+	var listed []string
+	for _, conference := range data.GetAllConferences() {
+		if s.Conferences[conference] {
+			listed = append(listed, conference)
+		}
 	}
+	if len(listed) == len(data.GetAllConferences()) {
+		listed = nil
+	}
+	settings.Conferences = listed
 
-	err := data.SaveSettings(settings)
+	err := data.SaveSettings(&settings)
 	if err == nil {
+		s.settings = &settings
 		s.HasChanges = false
 	}
 	return err
 }
 
-// SelectedCount returns the number of selected leagues.
-func (s *SettingsState) SelectedCount() int {
+// Settings returns the settings as last loaded or saved.
+func (s *SettingsState) Settings() *data.Settings {
+	return s.settings
+}
+
+// LoadErr returns why settings.yaml couldn't be read, or nil.
+func (s *SettingsState) LoadErr() error {
+	return s.loadErr
+}
+
+// FavoriteCount returns the number of favorite NBA teams.
+func (s *SettingsState) FavoriteCount() int {
 	count := 0
-	for _, isSelected := range s.Selected {
-		if isSelected {
+	for _, team := range data.NBATeams {
+		if s.Favorites[team.Tricode] {
 			count++
 		}
 	}
 	return count
 }
 
+// listedConferences describes which conferences' games the lists show.
+func (s *SettingsState) listedConferences() string {
+	var listed []string
+	for _, conference := range data.GetAllConferences() {
+		if s.Conferences[conference] {
+			listed = append(listed, conference)
+		}
+	}
+	if len(listed) == 1 {
+		return "Listing " + listed[0] + " games only"
+	}
+	return "Listing every game"
+}
+
 // Fixed width for settings panel (30% wider than original 48)
 const settingsBoxWidth = 62
 
-// renderTabBar renders the regional tabs at the top of the settings view.
-func renderTabBar(regions []string, currentRegion int, width int) string {
+// renderTabBar renders the tabs at the top of the settings view.
+func renderTabBar(tabs []string, currentTab int, width int) string {
 	var tabElements []string
 
-	for i, region := range regions {
+	for i, tab := range tabs {
 		var tabStyle lipgloss.Style
 
-		if i == currentRegion {
+		if i == currentTab {
 			// Active tab - neon cyan
 			tabStyle = lipgloss.NewStyle().
 				Foreground(neonCyan).
@@ -189,17 +257,17 @@ func renderTabBar(regions []string, currentRegion int, width int) string {
 				Padding(0, 2)
 		}
 
-		tabElements = append(tabElements, tabStyle.Render(region))
+		tabElements = append(tabElements, tabStyle.Render(tab))
 	}
 
 	// Join tabs with separator
-	tabs := lipgloss.JoinHorizontal(lipgloss.Left, tabElements...)
+	bar := lipgloss.JoinHorizontal(lipgloss.Left, tabElements...)
 
 	// Center the tab bar
-	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(tabs)
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(bar)
 }
 
-// RenderSettingsView renders the settings view for league customization.
+// RenderSettingsView renders the settings view for favorite teams and list filters.
 // Uses minimal styling consistent with the rest of the app (red/cyan neon theme).
 // bannerType determines what status banner (if any) to display at the top.
 func RenderSettingsView(width, height int, state *SettingsState, bannerType constants.StatusBannerType) string {
@@ -230,10 +298,10 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 	}
 
 	// Title - compact header with gradient and diagonal fill
-	title := design.RenderHeader(constants.PanelLeaguePreferences, settingsBoxWidth)
+	title := design.RenderHeader(constants.PanelSettings, settingsBoxWidth)
 
 	// Render the tab bar
-	tabs := renderTabBar(state.Regions, state.CurrentRegion, settingsBoxWidth)
+	tabs := renderTabBar(state.Tabs, state.CurrentTab, settingsBoxWidth)
 
 	// Render the list
 	listContent := state.List.View()
@@ -241,14 +309,21 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 	listContent = listContainerStyle.Render(listContent)
 
 	// Selection info
-	selectedCount := state.SelectedCount()
 	var infoText string
-	if selectedCount == 0 {
-		infoText = "No selection = default leagues"
-	} else {
-		infoText = fmt.Sprintf("%d of %d selected", selectedCount, len(state.AllLeagues))
+	switch count := state.FavoriteCount(); {
+	case tabConference(state.Tabs[state.CurrentTab]) == "":
+		infoText = state.listedConferences()
+	case count == 0:
+		infoText = "No favorite teams yet"
+	default:
+		infoText = fmt.Sprintf("%d of %d teams are favorites", count, len(data.NBATeams))
 	}
 	infoStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
+	if state.loadErr != nil {
+		// Changes can't be saved, so say why in place of the selection info
+		infoText = "settings.yaml not read, changes won't be saved: " + state.loadErr.Error()
+		infoStyle = lipgloss.NewStyle().Foreground(neonRed).Width(settingsBoxWidth).Align(lipgloss.Center)
+	}
 	info := infoStyle.Render(infoText)
 
 	// Help text - update to include tab navigation
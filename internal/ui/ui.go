@@ -23,11 +23,15 @@ func renderStatusBanner(bannerType constants.StatusBannerType, width int) string
 
 	switch bannerType {
 	case constants.StatusBannerDebug:
-		message = "[DEBUG MODE] Logs: ~/.golazo/golazo_debug.log"
+		message = "[DEBUG MODE] Logs: courtside_debug.log in the state directory"
 	case constants.StatusBannerNewVersion:
 		message = "New Version Available! Run 'golazo --update'"
 	case constants.StatusBannerDev:
 		message = "[DEV BUILD] This is a development version"
+	case constants.StatusBannerMigrated:
+		message = "Courtside has its own directories now; nothing was carried over from Golazo's (courtside config path)"
+	case constants.StatusBannerSettings:
+		message = "Some settings are not valid, run 'courtside config validate'"
 	case constants.StatusBannerNone:
 		fallthrough
 	default:
@@ -4,36 +4,49 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gabriel7419/courtside/internal/data"
 	"github.com/gabriel7419/courtside/internal/reddit"
 )
 
 func main() {
-	// Create Reddit client
-	client, err := reddit.NewClient()
+	// Create Reddit client with the credentials in settings and the environment
+	settings, err := data.ReadSettings()
+	if err != nil {
+		fmt.Printf("Error reading settings: %v\n", err)
+		return
+	}
+	settings, err = settings.Effective()
+	if err != nil {
+		fmt.Printf("Settings from the environment: %v\n", err)
+	}
+	client, err := reddit.NewClient(settings.Reddit)
 	if err != nil {
 		fmt.Printf("Error creating Reddit client: %v\n", err)
 		return
 	}
 
-	// Test the Everton vs Brentford goal
-	goal := reddit.GoalInfo{
+	// Test a late go-ahead basket
+	play := reddit.PlayInfo{
 		MatchID:    0, // We don't know the match ID yet
-		HomeTeam:   "Everton",
-		AwayTeam:   "Brentford",
-		ScorerName: "Igor Thiago",
-		Minute:     11,
-		HomeScore:  0,
-		AwayScore:  1,
-		IsHomeTeam: false, // Igor Thiago scored for Brentford (away team)
+		EventID:    612,
+		Period:     4,
+		Clock:      "0:04",
+		HomeTeam:   "Boston Celtics",
+		AwayTeam:   "Miami Heat",
+		Player:     "J. Tatum",
+		Kind:       "game-winner",
+		HomeScore:  104,
+		AwayScore:  103,
+		IsHomeTeam: true,
 		MatchTime:  time.Now().Add(-24 * time.Hour), // Assume yesterday
 	}
 
-	fmt.Printf("Searching for goal: %+v\n", goal)
+	fmt.Printf("Searching for play: %+v\n", play)
 
 	// Test the search
-	link, err := client.GoalLink(goal)
+	link, err := client.Highlight(play)
 	if err != nil {
-		fmt.Printf("Error searching for goal: %v\n", err)
+		fmt.Printf("Error searching for play: %v\n", err)
 		return
 	}
 